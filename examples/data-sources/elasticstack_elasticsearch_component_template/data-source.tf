@@ -0,0 +1,11 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_component_template" "logs_mappings" {
+  name = "logs-mappings"
+}
+
+output "logs_mappings" {
+  value = data.elasticstack_elasticsearch_component_template.logs_mappings.template[0].mappings
+}
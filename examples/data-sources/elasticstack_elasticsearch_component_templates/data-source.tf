@@ -0,0 +1,11 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_component_templates" "logs" {
+  name_pattern = "logs-*"
+}
+
+output "component_template_names" {
+  value = data.elasticstack_elasticsearch_component_templates.logs.component_templates[*].name
+}
@@ -0,0 +1,11 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_data_stream" "logs" {
+  name = "logs-nginx-default"
+}
+
+output "logs_backing_indices" {
+  value = data.elasticstack_elasticsearch_data_stream.logs.indices[*].index_name
+}
@@ -0,0 +1,11 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_data_streams" "logs" {
+  name_pattern = "logs-*"
+}
+
+output "data_stream_names" {
+  value = data.elasticstack_elasticsearch_data_streams.logs.data_streams[*].name
+}
@@ -0,0 +1,11 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_index" "logs" {
+  name = "logs-2024.01"
+}
+
+output "logs_mappings" {
+  value = data.elasticstack_elasticsearch_index.logs.mappings
+}
@@ -0,0 +1,11 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_index_lifecycle" "logs" {
+  name = "logs"
+}
+
+output "logs_delete_after" {
+  value = data.elasticstack_elasticsearch_index_lifecycle.logs.delete[0].min_age
+}
@@ -0,0 +1,16 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_index_lifecycle_explain" "logs" {
+  index        = "logs-*"
+  only_managed = true
+}
+
+output "failed_indices" {
+  value = [for i in data.elasticstack_elasticsearch_index_lifecycle_explain.logs.indices : i.index if i.step == "ERROR"]
+}
+
+output "outdated_indices" {
+  value = [for i in data.elasticstack_elasticsearch_index_lifecycle_explain.logs.indices : i.index if i.phase_definition_outdated]
+}
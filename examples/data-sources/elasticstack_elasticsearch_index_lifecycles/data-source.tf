@@ -0,0 +1,9 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_index_lifecycles" "all" {}
+
+output "policy_names" {
+  value = data.elasticstack_elasticsearch_index_lifecycles.all.policies[*].name
+}
@@ -0,0 +1,11 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_index_template" "logs" {
+  name = "logs"
+}
+
+output "logs_index_patterns" {
+  value = data.elasticstack_elasticsearch_index_template.logs.index_patterns
+}
@@ -0,0 +1,29 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+# Resolve the configuration a new index would get from the existing index templates
+data "elasticstack_elasticsearch_index_template_simulate" "logs" {
+  index_name = "logs-2025"
+}
+
+# Preview the effect of an index template before creating it
+data "elasticstack_elasticsearch_index_template_simulate" "logs_preview" {
+  index_name = "logs-2025"
+
+  index_template {
+    index_patterns = ["logs-*"]
+    composed_of    = ["logs-mappings", "logs-settings"]
+    priority       = 200
+
+    template {
+      settings = jsonencode({
+        number_of_replicas = 2
+      })
+    }
+  }
+}
+
+output "logs_settings" {
+  value = jsondecode(data.elasticstack_elasticsearch_index_template_simulate.logs_preview.settings)
+}
@@ -0,0 +1,9 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_index_templates" "all" {}
+
+output "index_template_names" {
+  value = data.elasticstack_elasticsearch_index_templates.all.index_templates[*].name
+}
@@ -0,0 +1,11 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_indices" "logs" {
+  name_pattern = "logs-*"
+}
+
+output "logs_indices" {
+  value = data.elasticstack_elasticsearch_indices.logs.indices[*].name
+}
@@ -0,0 +1,20 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_has_privileges" "pipeline" {
+  username = "ci-pipeline"
+  cluster  = ["monitor", "manage_ingest_pipelines"]
+
+  index {
+    names      = ["logs-app-*"]
+    privileges = ["create_doc", "view_index_metadata"]
+  }
+}
+
+check "pipeline_privileges" {
+  assert {
+    condition     = data.elasticstack_elasticsearch_security_has_privileges.pipeline.has_all_requested
+    error_message = "The ci-pipeline user is missing privileges: ${jsonencode(data.elasticstack_elasticsearch_security_has_privileges.pipeline.index_results)}"
+  }
+}
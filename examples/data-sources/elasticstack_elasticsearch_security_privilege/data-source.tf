@@ -0,0 +1,12 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_privilege" "read" {
+  application = "myapp"
+  name        = "read"
+}
+
+output "actions" {
+  value = data.elasticstack_elasticsearch_security_privilege.read.actions
+}
@@ -0,0 +1,26 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_test" "admin" {
+  rules = jsonencode({
+    any = [
+      { field = { groups = "cn=admins,ou=groups,dc=example,dc=com" } },
+      { field = { dn = "*,ou=admins,dc=example,dc=com" } },
+    ]
+  })
+
+  user {
+    username = "jdoe"
+    dn       = "cn=jdoe,ou=people,dc=example,dc=com"
+    groups   = ["cn=admins,ou=groups,dc=example,dc=com"]
+    realm    = "ldap1"
+  }
+}
+
+check "ldap_admins_mapping" {
+  assert {
+    condition     = data.elasticstack_elasticsearch_security_role_mapping_test.admin.match
+    error_message = "Members of the admins group should be mapped to the superuser role."
+  }
+}
@@ -0,0 +1,19 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_roles" "team" {
+  name_pattern = "team-*"
+}
+
+# Maps a role mapping to each of the team roles
+resource "elasticstack_elasticsearch_security_role_mapping" "team" {
+  for_each = { for role in data.elasticstack_elasticsearch_security_roles.team.roles : role.name => role }
+
+  name    = each.key
+  enabled = true
+  roles   = [each.key]
+  rules = jsonencode({
+    field = { groups = "cn=${each.key},ou=groups,dc=example,dc=com" }
+  })
+}
@@ -0,0 +1,11 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_user_privileges" "pipeline" {
+  username = "ci-pipeline"
+}
+
+output "cluster_privileges" {
+  value = data.elasticstack_elasticsearch_security_user_privileges.pipeline.cluster
+}
@@ -0,0 +1,14 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_users" "service_accounts" {
+  username_pattern = "svc-*"
+  metadata = {
+    team = "platform"
+  }
+}
+
+output "disabled_service_accounts" {
+  value = [for user in data.elasticstack_elasticsearch_security_users.service_accounts.users : user.username if !user.enabled]
+}
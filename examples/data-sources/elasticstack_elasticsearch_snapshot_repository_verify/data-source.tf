@@ -0,0 +1,29 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "my_repo" {
+  name = "my_repo"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+data "elasticstack_elasticsearch_snapshot_repository_verify" "my_repo" {
+  name = elasticstack_elasticsearch_snapshot_repository.my_repo.name
+
+  analyze {
+    blob_count          = 10
+    max_blob_size       = "1mb"
+    max_total_data_size = "10mb"
+  }
+}
+
+output "repo_nodes" {
+  value = data.elasticstack_elasticsearch_snapshot_repository_verify.my_repo.nodes[*].name
+}
+
+output "repo_write_throughput" {
+  value = data.elasticstack_elasticsearch_snapshot_repository_verify.my_repo.analysis[0].write_throughput_bytes_per_sec
+}
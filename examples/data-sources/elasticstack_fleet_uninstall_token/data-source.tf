@@ -0,0 +1,13 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_fleet_agent_policy" "protected" {
+  name         = "Protected Policy"
+  namespace    = "default"
+  is_protected = true
+}
+
+data "elasticstack_fleet_uninstall_token" "protected" {
+  policy_id = elasticstack_fleet_agent_policy.protected.policy_id
+}
@@ -0,0 +1,25 @@
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+data "elasticstack_kibana_features" "all" {}
+
+# Grants all the features except Dev Tools
+resource "elasticstack_kibana_security_role" "example" {
+  name = "all_but_dev_tools"
+  elasticsearch {
+    cluster = ["monitor"]
+  }
+  kibana {
+    dynamic "feature" {
+      for_each = [for f in data.elasticstack_kibana_features.all.features : f.id if f.id != "dev_tools" && contains(f.privileges, "all")]
+      content {
+        name       = feature.value
+        privileges = ["all"]
+      }
+    }
+
+    spaces = ["default"]
+  }
+}
@@ -0,0 +1,15 @@
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+data "elasticstack_kibana_slo" "auth_server_latency" {
+  name = "Auth server latency"
+}
+
+check "auth_server_latency_error_budget" {
+  assert {
+    condition     = data.elasticstack_kibana_slo.auth_server_latency.summary[0].error_budget_remaining > 0.1
+    error_message = "The auth server latency SLO has less than 10% of its error budget remaining."
+  }
+}
@@ -0,0 +1,23 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+# Changing the type of a field copies the documents to a successor index, named
+# my-index-v2, and moves the aliases to it instead of recreating the index.
+resource "elasticstack_elasticsearch_index" "my_index" {
+  name               = "my-index"
+  migration_strategy = "reindex"
+
+  alias {
+    name           = "my-data"
+    is_write_index = true
+  }
+
+  mappings = jsonencode({
+    properties = {
+      status_code = { type = "long" }
+    }
+  })
+
+  deletion_protection = false
+}
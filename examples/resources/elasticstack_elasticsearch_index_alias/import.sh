@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_index_alias.my_alias <cluster_uuid>/<alias_name>
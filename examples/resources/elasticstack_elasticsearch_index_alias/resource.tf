@@ -0,0 +1,34 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "logs_2024" {
+  name                = "logs-2024"
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_index" "logs_2025" {
+  name                = "logs-2025"
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_index_alias" "logs" {
+  name = "logs"
+
+  index {
+    name           = elasticstack_elasticsearch_index.logs_2025.name
+    is_write_index = true
+  }
+
+  index {
+    name = elasticstack_elasticsearch_index.logs_2024.name
+    filter = jsonencode({
+      term = { "service.name" = "checkout" }
+    })
+  }
+
+  # Indices not managed by Terraform can be matched with a pattern
+  index {
+    name = "logs-archive-*"
+  }
+}
@@ -0,0 +1,52 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index_lifecycle" "logs" {
+  name = "logs"
+
+  hot {
+    rollover {
+      max_age = "1d"
+    }
+  }
+
+  delete {
+    min_age = "30d"
+    delete {}
+  }
+}
+
+// Retry the failed steps of the logs indices
+resource "elasticstack_elasticsearch_index_lifecycle_step" "retry" {
+  index              = "logs-*"
+  retry_failed_steps = true
+}
+
+// Apply the latest version of the policy to the indices already in a phase,
+// each time the policy is updated
+resource "elasticstack_elasticsearch_index_lifecycle_step" "refresh" {
+  index = "logs-*"
+
+  move_to_step {}
+
+  triggers = {
+    policy = elasticstack_elasticsearch_index_lifecycle.logs.modified_date
+  }
+}
+
+// Move the indices waiting for their rollover to the delete phase
+resource "elasticstack_elasticsearch_index_lifecycle_step" "delete" {
+  index = "logs-000001"
+
+  move_to_step {
+    current_step {
+      phase  = "hot"
+      action = "rollover"
+      name   = "check-rollover-ready"
+    }
+    next_step {
+      phase = "delete"
+    }
+  }
+}
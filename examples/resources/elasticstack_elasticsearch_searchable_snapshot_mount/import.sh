@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_searchable_snapshot_mount.audit <cluster_uuid>/<mounted_index_name>
@@ -0,0 +1,18 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+// Mount an archived index on the frozen tier for an investigation
+resource "elasticstack_elasticsearch_searchable_snapshot_mount" "audit" {
+  repository    = "archives"
+  snapshot      = "audit-2023"
+  index         = "audit-2023.06"
+  renamed_index = "investigation-audit-2023.06"
+  storage       = "shared_cache"
+
+  index_settings = jsonencode({
+    "index.number_of_replicas" = 0
+  })
+
+  ignore_index_settings = ["index.lifecycle.name"]
+}
@@ -0,0 +1,22 @@
+resource "elasticstack_elasticsearch_security_api_key" "cross_cluster_key" {
+  name = "My Cross-Cluster API key"
+  type = "cross_cluster"
+
+  # Set the access for the remote cluster
+  access {
+    search {
+      names = ["logs-*", "metrics-*"]
+    }
+    replication {
+      names = ["archive-*"]
+    }
+  }
+
+  # Set the expiration for the API key
+  expiration = "30d"
+}
+
+output "cross_cluster_api_key" {
+  value     = elasticstack_elasticsearch_security_api_key.cross_cluster_key.encoded
+  sensitive = true
+}
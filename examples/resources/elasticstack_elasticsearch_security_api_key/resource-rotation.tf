@@ -0,0 +1,21 @@
+resource "elasticstack_elasticsearch_security_api_key" "rotating_key" {
+  name = "My rotating API key"
+
+  role_descriptors = jsonencode({
+    role-a = {
+      cluster = ["monitor"]
+    }
+  })
+
+  # Create a new API key every 30 days, and keep the previous one valid for another day
+  rotation_period = "720h"
+  overlap         = "24h"
+}
+
+output "api_keys" {
+  value = compact([
+    elasticstack_elasticsearch_security_api_key.rotating_key.encoded,
+    elasticstack_elasticsearch_security_api_key.rotating_key.previous_encoded,
+  ])
+  sensitive = true
+}
@@ -0,0 +1,43 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role_mapping" "ldap_admins" {
+  name  = "ldap_admins"
+  roles = ["superuser"]
+
+  rule {
+    all {
+      rule {
+        field {
+          name   = "realm.name"
+          values = ["ldap1"]
+        }
+      }
+      rule {
+        any {
+          rule {
+            field {
+              name   = "groups"
+              values = ["cn=admins,ou=groups,dc=example,dc=com"]
+            }
+          }
+          rule {
+            field {
+              name   = "dn"
+              values = ["*,ou=admins,dc=example,dc=com"]
+            }
+          }
+        }
+      }
+      rule {
+        except {
+          field {
+            name        = "metadata.contractor"
+            bool_values = [true]
+          }
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,13 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_service_token" "fleet_server" {
+  service_account = "elastic/fleet-server"
+  name            = "fleet-server-token"
+}
+
+output "fleet_server_service_token" {
+  value     = elasticstack_elasticsearch_security_service_token.fleet_server.value
+  sensitive = true
+}
@@ -0,0 +1,19 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+variable "svc_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "elasticstack_elasticsearch_security_user" "svc" {
+  username = "svc"
+  roles    = ["editor"]
+
+  # Hashed client-side, only the bcrypt hash is stored in the state.
+  password_wo                = var.svc_password
+  password_hashing_algorithm = "bcrypt"
+  # Bump to send a new svc_password.
+  password_version = 1
+}
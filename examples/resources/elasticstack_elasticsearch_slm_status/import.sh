@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_slm_status.status <cluster_uuid>/slm-status
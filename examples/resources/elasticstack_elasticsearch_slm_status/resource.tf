@@ -0,0 +1,8 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+// pause the snapshot lifecycle policies during a maintenance
+resource "elasticstack_elasticsearch_slm_status" "status" {
+  operation_mode = "STOPPED"
+}
@@ -0,0 +1,23 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "backups" {
+  name = "backups"
+
+  fs {
+    location = "/mnt/backups"
+  }
+}
+
+// Snapshot the orders indices before applying a risky change
+resource "elasticstack_elasticsearch_snapshot" "pre_change" {
+  repository           = elasticstack_elasticsearch_snapshot_repository.backups.name
+  name                 = "orders-pre-migration"
+  indices              = ["orders-*"]
+  include_global_state = false
+
+  metadata = jsonencode({
+    reason = "before the orders mapping migration"
+  })
+}
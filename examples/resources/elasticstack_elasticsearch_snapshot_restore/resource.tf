@@ -0,0 +1,20 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+// Restore the orders indices of a production snapshot into a test cluster,
+// under new names and without replicas
+resource "elasticstack_elasticsearch_snapshot_restore" "orders" {
+  repository         = "production-backups"
+  snapshot           = "nightly-2024.01.15"
+  indices            = ["orders-*"]
+  include_aliases    = false
+  rename_pattern     = "orders-(.+)"
+  rename_replacement = "test-orders-$1"
+
+  index_settings = jsonencode({
+    "index.number_of_replicas" = 0
+  })
+
+  ignore_index_settings = ["index.lifecycle.name"]
+}
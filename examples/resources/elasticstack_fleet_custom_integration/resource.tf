@@ -0,0 +1,7 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_fleet_custom_integration" "custom_logs" {
+  package_path = "${path.module}/packages/custom_logs-1.0.0.zip"
+}
@@ -0,0 +1 @@
+terraform import elasticstack_fleet_enrollment_token.my_token <fleet_enrollment_token_id>
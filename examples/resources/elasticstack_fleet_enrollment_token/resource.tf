@@ -0,0 +1,22 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_fleet_agent_policy" "test_policy" {
+  name      = "Test Policy"
+  namespace = "default"
+}
+
+resource "elasticstack_fleet_enrollment_token" "test_token" {
+  policy_id = elasticstack_fleet_agent_policy.test_policy.policy_id
+  name      = "Test Token"
+
+  # Change the rotation value to revoke the token and issue a new one.
+  rotation = {
+    version = "1"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
@@ -176,3 +176,55 @@ resource "elasticstack_kibana_slo" "custom_metric" {
   }
 
 }
+
+//Available from 8.12.0
+resource "elasticstack_kibana_slo" "timeslice_metric" {
+  name        = "timeslice metric"
+  description = "timeslice metric"
+
+  timeslice_metric_indicator {
+    index = "metrics-apm*"
+    metric {
+      metrics {
+        name        = "A"
+        aggregation = "sum"
+        field       = "transaction.duration.histogram"
+      }
+      metrics {
+        name        = "B"
+        aggregation = "percentile"
+        field       = "transaction.duration.histogram"
+        percentile  = 99
+      }
+      metrics {
+        name        = "C"
+        aggregation = "doc_count"
+        filter      = "event.outcome: failure"
+      }
+      equation   = "(A + B) / C"
+      comparator = "LT"
+      threshold  = 500
+    }
+  }
+
+  time_window {
+    duration = "7d"
+    type     = "rolling"
+  }
+
+  budgeting_method = "timeslices"
+
+  objective {
+    target           = 0.95
+    timeslice_target = 0.95
+    timeslice_window = "5m"
+  }
+
+  //Grouping by more than one field is available from 8.14.0
+  group_by = ["service.name", "service.environment"]
+
+  settings {
+    //Available from 8.15.0
+    prevent_initial_backfill = true
+  }
+}
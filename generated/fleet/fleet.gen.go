@@ -187,13 +187,14 @@ type AgentPolicy struct {
 		Enabled bool   `json:"enabled"`
 		Name    string `json:"name"`
 	} `json:"agent_features,omitempty"`
-	Agents            *float32 `json:"agents,omitempty"`
-	DataOutputId      *string  `json:"data_output_id"`
-	Description       *string  `json:"description,omitempty"`
-	DownloadSourceId  *string  `json:"download_source_id"`
-	FleetServerHostId *string  `json:"fleet_server_host_id"`
-	Id                string   `json:"id"`
-	InactivityTimeout *float32 `json:"inactivity_timeout,omitempty"`
+	Agents            *float32                         `json:"agents,omitempty"`
+	DataOutputId      *string                          `json:"data_output_id"`
+	Description       *string                          `json:"description,omitempty"`
+	DownloadSourceId  *string                          `json:"download_source_id"`
+	FleetServerHostId *string                          `json:"fleet_server_host_id"`
+	GlobalDataTags    *[]AgentPolicyGlobalDataTagsItem `json:"global_data_tags,omitempty"`
+	Id                string                           `json:"id"`
+	InactivityTimeout *float32                         `json:"inactivity_timeout,omitempty"`
 
 	// IsProtected Indicates whether the agent policy has tamper protection enabled. Default false.
 	IsProtected        *bool                           `json:"is_protected,omitempty"`
@@ -203,11 +204,14 @@ type AgentPolicy struct {
 	Namespace          string                          `json:"namespace"`
 
 	// Overrides Override settings that are defined in the agent policy. Input settings cannot be overridden. The override option should be used only in unusual circumstances and not as a routine procedure.
-	Overrides       *map[string]interface{} `json:"overrides"`
-	Revision        *float32                `json:"revision,omitempty"`
-	UnenrollTimeout *float32                `json:"unenroll_timeout,omitempty"`
-	UpdatedBy       *string                 `json:"updated_by,omitempty"`
-	UpdatedOn       *time.Time              `json:"updated_on,omitempty"`
+	Overrides *map[string]interface{} `json:"overrides"`
+	Revision  *float32                `json:"revision,omitempty"`
+
+	// SupportsAgentless Indicates whether the agent policy supports agentless integrations.
+	SupportsAgentless *bool      `json:"supports_agentless,omitempty"`
+	UnenrollTimeout   *float32   `json:"unenroll_timeout,omitempty"`
+	UpdatedBy         *string    `json:"updated_by,omitempty"`
+	UpdatedOn         *time.Time `json:"updated_on,omitempty"`
 }
 
 // AgentPolicyMonitoringEnabled defines model for AgentPolicy.MonitoringEnabled.
@@ -223,6 +227,7 @@ type AgentPolicyCreateRequest struct {
 	Description        *string                                      `json:"description,omitempty"`
 	DownloadSourceId   *string                                      `json:"download_source_id"`
 	FleetServerHostId  *string                                      `json:"fleet_server_host_id"`
+	GlobalDataTags     *[]AgentPolicyGlobalDataTagsItem             `json:"global_data_tags,omitempty"`
 	Id                 *string                                      `json:"id,omitempty"`
 	InactivityTimeout  *float32                                     `json:"inactivity_timeout,omitempty"`
 	IsProtected        *bool                                        `json:"is_protected,omitempty"`
@@ -230,12 +235,21 @@ type AgentPolicyCreateRequest struct {
 	MonitoringOutputId *string                                      `json:"monitoring_output_id"`
 	Name               string                                       `json:"name"`
 	Namespace          string                                       `json:"namespace"`
-	UnenrollTimeout    *float32                                     `json:"unenroll_timeout,omitempty"`
+
+	// SupportsAgentless Indicates whether the agent policy supports agentless integrations.
+	SupportsAgentless *bool    `json:"supports_agentless,omitempty"`
+	UnenrollTimeout   *float32 `json:"unenroll_timeout,omitempty"`
 }
 
 // AgentPolicyCreateRequestMonitoringEnabled defines model for AgentPolicyCreateRequest.MonitoringEnabled.
 type AgentPolicyCreateRequestMonitoringEnabled string
 
+// AgentPolicyGlobalDataTagsItem defines model for agent_policy_global_data_tags_item.
+type AgentPolicyGlobalDataTagsItem struct {
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
+}
+
 // AgentPolicyUpdateRequest defines model for agent_policy_update_request.
 type AgentPolicyUpdateRequest struct {
 	AgentFeatures *[]struct {
@@ -246,13 +260,17 @@ type AgentPolicyUpdateRequest struct {
 	Description        *string                                      `json:"description,omitempty"`
 	DownloadSourceId   *string                                      `json:"download_source_id"`
 	FleetServerHostId  *string                                      `json:"fleet_server_host_id"`
+	GlobalDataTags     *[]AgentPolicyGlobalDataTagsItem             `json:"global_data_tags,omitempty"`
 	InactivityTimeout  *float32                                     `json:"inactivity_timeout,omitempty"`
 	IsProtected        *bool                                        `json:"is_protected,omitempty"`
 	MonitoringEnabled  *[]AgentPolicyUpdateRequestMonitoringEnabled `json:"monitoring_enabled,omitempty"`
 	MonitoringOutputId *string                                      `json:"monitoring_output_id"`
 	Name               string                                       `json:"name"`
 	Namespace          string                                       `json:"namespace"`
-	UnenrollTimeout    *float32                                     `json:"unenroll_timeout,omitempty"`
+
+	// SupportsAgentless Indicates whether the agent policy supports agentless integrations.
+	SupportsAgentless *bool    `json:"supports_agentless,omitempty"`
+	UnenrollTimeout   *float32 `json:"unenroll_timeout,omitempty"`
 }
 
 // AgentPolicyUpdateRequestMonitoringEnabled defines model for AgentPolicyUpdateRequest.MonitoringEnabled.
@@ -765,6 +783,21 @@ type SearchResult struct {
 	Version     string                  `json:"version"`
 }
 
+// UninstallToken defines model for uninstall_token.
+type UninstallToken struct {
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+	PolicyId  string `json:"policy_id"`
+	Token     string `json:"token"`
+}
+
+// UninstallTokenMetadata defines model for uninstall_token_metadata.
+type UninstallTokenMetadata struct {
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+	PolicyId  string `json:"policy_id"`
+}
+
 // Format defines model for format.
 type Format string
 
@@ -780,6 +813,17 @@ type DeleteAgentPolicyJSONBody struct {
 	AgentPolicyId string `json:"agentPolicyId"`
 }
 
+// CreateEnrollmentApiKeysJSONBody defines parameters for CreateEnrollmentApiKeys.
+type CreateEnrollmentApiKeysJSONBody struct {
+	Expiration *string `json:"expiration,omitempty"`
+
+	// Name The name of the enrollment API key. Must be unique.
+	Name *string `json:"name,omitempty"`
+
+	// PolicyId The ID of the agent policy the Elastic Agent will be enrolled in.
+	PolicyId string `json:"policy_id"`
+}
+
 // ListAllPackagesParams defines parameters for ListAllPackages.
 type ListAllPackagesParams struct {
 	// ExcludeInstallStatus Whether to exclude the install status of each package. Enabling this option will opt in to caching for the response via `cache-control` headers. If you don't need up-to-date installation info for a package, and are querying for a list of available packages, providing this flag can improve performance substantially.
@@ -902,6 +946,16 @@ type UpdatePackagePolicyParams struct {
 // UpdatePackagePolicyParamsFormat defines parameters for UpdatePackagePolicy.
 type UpdatePackagePolicyParamsFormat string
 
+// GetUninstallTokensParams defines parameters for GetUninstallTokens.
+type GetUninstallTokensParams struct {
+	// PolicyId Partial match filtering for policy IDs
+	PolicyId *string `form:"policyId,omitempty" json:"policyId,omitempty"`
+
+	// PerPage The number of items to return
+	PerPage *int `form:"perPage,omitempty" json:"perPage,omitempty"`
+	Page    *int `form:"page,omitempty" json:"page,omitempty"`
+}
+
 // CreateAgentPolicyJSONRequestBody defines body for CreateAgentPolicy for application/json ContentType.
 type CreateAgentPolicyJSONRequestBody = AgentPolicyCreateRequest
 
@@ -911,6 +965,9 @@ type DeleteAgentPolicyJSONRequestBody DeleteAgentPolicyJSONBody
 // UpdateAgentPolicyJSONRequestBody defines body for UpdateAgentPolicy for application/json ContentType.
 type UpdateAgentPolicyJSONRequestBody = AgentPolicyUpdateRequest
 
+// CreateEnrollmentApiKeysJSONRequestBody defines body for CreateEnrollmentApiKeys for application/json ContentType.
+type CreateEnrollmentApiKeysJSONRequestBody CreateEnrollmentApiKeysJSONBody
+
 // DeletePackageJSONRequestBody defines body for DeletePackage for application/json ContentType.
 type DeletePackageJSONRequestBody DeletePackageJSONBody
 
@@ -1332,9 +1389,23 @@ type ClientInterface interface {
 	// GetEnrollmentApiKeys request
 	GetEnrollmentApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateEnrollmentApiKeysWithBody request with any body
+	CreateEnrollmentApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateEnrollmentApiKeys(ctx context.Context, body CreateEnrollmentApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteEnrollmentApiKey request
+	DeleteEnrollmentApiKey(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEnrollmentApiKey request
+	GetEnrollmentApiKey(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListAllPackages request
 	ListAllPackages(ctx context.Context, params *ListAllPackagesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// InstallPackageByUploadWithBody request with any body
+	InstallPackageByUploadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeletePackageWithBody request with any body
 	DeletePackageWithBody(ctx context.Context, pkgName string, pkgVersion string, params *DeletePackageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1400,6 +1471,12 @@ type ClientInterface interface {
 	UpdatePackagePolicyWithBody(ctx context.Context, packagePolicyId string, params *UpdatePackagePolicyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdatePackagePolicy(ctx context.Context, packagePolicyId string, params *UpdatePackagePolicyParams, body UpdatePackagePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUninstallTokens request
+	GetUninstallTokens(ctx context.Context, params *GetUninstallTokensParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUninstallToken request
+	GetUninstallToken(ctx context.Context, uninstallTokenId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) CreateAgentPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -1498,6 +1575,54 @@ func (c *Client) GetEnrollmentApiKeys(ctx context.Context, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
+func (c *Client) CreateEnrollmentApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnrollmentApiKeysRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateEnrollmentApiKeys(ctx context.Context, body CreateEnrollmentApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnrollmentApiKeysRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteEnrollmentApiKey(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteEnrollmentApiKeyRequest(c.Server, keyId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEnrollmentApiKey(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnrollmentApiKeyRequest(c.Server, keyId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListAllPackages(ctx context.Context, params *ListAllPackagesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListAllPackagesRequest(c.Server, params)
 	if err != nil {
@@ -1510,6 +1635,18 @@ func (c *Client) ListAllPackages(ctx context.Context, params *ListAllPackagesPar
 	return c.Client.Do(req)
 }
 
+func (c *Client) InstallPackageByUploadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInstallPackageByUploadRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DeletePackageWithBody(ctx context.Context, pkgName string, pkgVersion string, params *DeletePackageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeletePackageRequestWithBody(c.Server, pkgName, pkgVersion, params, contentType, body)
 	if err != nil {
@@ -1890,6 +2027,30 @@ func NewDeleteAgentPolicyRequestWithBody(server string, contentType string, body
 	return req, nil
 }
 
+func (c *Client) GetUninstallTokens(ctx context.Context, params *GetUninstallTokensParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUninstallTokensRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUninstallToken(ctx context.Context, uninstallTokenId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUninstallTokenRequest(c.Server, uninstallTokenId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewAgentPolicyInfoRequest generates requests for AgentPolicyInfo
 func NewAgentPolicyInfoRequest(server string, agentPolicyId string) (*http.Request, error) {
 	var err error
@@ -1998,6 +2159,114 @@ func NewGetEnrollmentApiKeysRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewCreateEnrollmentApiKeysRequest calls the generic CreateEnrollmentApiKeys builder with application/json body
+func NewCreateEnrollmentApiKeysRequest(server string, body CreateEnrollmentApiKeysJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateEnrollmentApiKeysRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateEnrollmentApiKeysRequestWithBody generates requests for CreateEnrollmentApiKeys with any type of body
+func NewCreateEnrollmentApiKeysRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/enrollment_api_keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteEnrollmentApiKeyRequest generates requests for DeleteEnrollmentApiKey
+func NewDeleteEnrollmentApiKeyRequest(server string, keyId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "keyId", runtime.ParamLocationPath, keyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/enrollment_api_keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEnrollmentApiKeyRequest generates requests for GetEnrollmentApiKey
+func NewGetEnrollmentApiKeyRequest(server string, keyId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "keyId", runtime.ParamLocationPath, keyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/enrollment_api_keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewListAllPackagesRequest generates requests for ListAllPackages
 func NewListAllPackagesRequest(server string, params *ListAllPackagesParams) (*http.Request, error) {
 	var err error
@@ -2095,6 +2364,35 @@ func NewListAllPackagesRequest(server string, params *ListAllPackagesParams) (*h
 	return req, nil
 }
 
+// NewInstallPackageByUploadRequestWithBody generates requests for InstallPackageByUpload with any type of body
+func NewInstallPackageByUploadRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/epm/packages")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewDeletePackageRequest calls the generic DeletePackage builder with application/json body
 func NewDeletePackageRequest(server string, pkgName string, pkgVersion string, params *DeletePackageParams, body DeletePackageJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -3067,48 +3365,163 @@ func NewUpdatePackagePolicyRequestWithBody(server string, packagePolicyId string
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewGetUninstallTokensRequest generates requests for GetUninstallTokens
+func NewGetUninstallTokensRequest(server string, params *GetUninstallTokensParams) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/uninstall_tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PolicyId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "policyId", runtime.ParamLocationQuery, *params.PolicyId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "perPage", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUninstallTokenRequest generates requests for GetUninstallToken
+func NewGetUninstallTokenRequest(server string, uninstallTokenId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uninstallTokenId", runtime.ParamLocationPath, uninstallTokenId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/uninstall_tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// CreateAgentPolicyWithBodyWithResponse request with any body
 	CreateAgentPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAgentPolicyResponse, error)
@@ -3131,9 +3544,23 @@ type ClientWithResponsesInterface interface {
 	// GetEnrollmentApiKeysWithResponse request
 	GetEnrollmentApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEnrollmentApiKeysResponse, error)
 
+	// CreateEnrollmentApiKeysWithBodyWithResponse request with any body
+	CreateEnrollmentApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnrollmentApiKeysResponse, error)
+
+	CreateEnrollmentApiKeysWithResponse(ctx context.Context, body CreateEnrollmentApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnrollmentApiKeysResponse, error)
+
+	// DeleteEnrollmentApiKeyWithResponse request
+	DeleteEnrollmentApiKeyWithResponse(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*DeleteEnrollmentApiKeyResponse, error)
+
+	// GetEnrollmentApiKeyWithResponse request
+	GetEnrollmentApiKeyWithResponse(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*GetEnrollmentApiKeyResponse, error)
+
 	// ListAllPackagesWithResponse request
 	ListAllPackagesWithResponse(ctx context.Context, params *ListAllPackagesParams, reqEditors ...RequestEditorFn) (*ListAllPackagesResponse, error)
 
+	// InstallPackageByUploadWithBodyWithResponse request with any body
+	InstallPackageByUploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InstallPackageByUploadResponse, error)
+
 	// DeletePackageWithBodyWithResponse request with any body
 	DeletePackageWithBodyWithResponse(ctx context.Context, pkgName string, pkgVersion string, params *DeletePackageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeletePackageResponse, error)
 
@@ -3199,6 +3626,12 @@ type ClientWithResponsesInterface interface {
 	UpdatePackagePolicyWithBodyWithResponse(ctx context.Context, packagePolicyId string, params *UpdatePackagePolicyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePackagePolicyResponse, error)
 
 	UpdatePackagePolicyWithResponse(ctx context.Context, packagePolicyId string, params *UpdatePackagePolicyParams, body UpdatePackagePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePackagePolicyResponse, error)
+
+	// GetUninstallTokensWithResponse request
+	GetUninstallTokensWithResponse(ctx context.Context, params *GetUninstallTokensParams, reqEditors ...RequestEditorFn) (*GetUninstallTokensResponse, error)
+
+	// GetUninstallTokenWithResponse request
+	GetUninstallTokenWithResponse(ctx context.Context, uninstallTokenId string, reqEditors ...RequestEditorFn) (*GetUninstallTokenResponse, error)
 }
 
 type CreateAgentPolicyResponse struct {
@@ -3332,6 +3765,82 @@ func (r GetEnrollmentApiKeysResponse) StatusCode() int {
 	return 0
 }
 
+type CreateEnrollmentApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Action string           `json:"action"`
+		Item   EnrollmentApiKey `json:"item"`
+	}
+	JSON400 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateEnrollmentApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateEnrollmentApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteEnrollmentApiKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Action string `json:"action"`
+	}
+	JSON400 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteEnrollmentApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteEnrollmentApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnrollmentApiKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Item EnrollmentApiKey `json:"item"`
+	}
+	JSON400 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnrollmentApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnrollmentApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ListAllPackagesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -3355,6 +3864,37 @@ func (r ListAllPackagesResponse) StatusCode() int {
 	return 0
 }
 
+type InstallPackageByUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Meta *struct {
+			InstallSource *PackageInstallSource `json:"install_source,omitempty"`
+		} `json:"_meta,omitempty"`
+		Items []struct {
+			Id   string          `json:"id"`
+			Type PackageItemType `json:"type"`
+		} `json:"items"`
+	}
+	JSON400 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r InstallPackageByUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InstallPackageByUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type DeletePackageResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -3810,6 +4350,59 @@ func (c *ClientWithResponses) DeleteAgentPolicyWithResponse(ctx context.Context,
 	return ParseDeleteAgentPolicyResponse(rsp)
 }
 
+type GetUninstallTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items   []UninstallTokenMetadata `json:"items"`
+		Page    float32                  `json:"page"`
+		PerPage float32                  `json:"perPage"`
+		Total   float32                  `json:"total"`
+	}
+	JSON400 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUninstallTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUninstallTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUninstallTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Item UninstallToken `json:"item"`
+	}
+	JSON400 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUninstallTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUninstallTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // AgentPolicyInfoWithResponse request returning *AgentPolicyInfoResponse
 func (c *ClientWithResponses) AgentPolicyInfoWithResponse(ctx context.Context, agentPolicyId string, reqEditors ...RequestEditorFn) (*AgentPolicyInfoResponse, error) {
 	rsp, err := c.AgentPolicyInfo(ctx, agentPolicyId, reqEditors...)
@@ -3845,6 +4438,41 @@ func (c *ClientWithResponses) GetEnrollmentApiKeysWithResponse(ctx context.Conte
 	return ParseGetEnrollmentApiKeysResponse(rsp)
 }
 
+// CreateEnrollmentApiKeysWithBodyWithResponse request with arbitrary body returning *CreateEnrollmentApiKeysResponse
+func (c *ClientWithResponses) CreateEnrollmentApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnrollmentApiKeysResponse, error) {
+	rsp, err := c.CreateEnrollmentApiKeysWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnrollmentApiKeysResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateEnrollmentApiKeysWithResponse(ctx context.Context, body CreateEnrollmentApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnrollmentApiKeysResponse, error) {
+	rsp, err := c.CreateEnrollmentApiKeys(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnrollmentApiKeysResponse(rsp)
+}
+
+// DeleteEnrollmentApiKeyWithResponse request returning *DeleteEnrollmentApiKeyResponse
+func (c *ClientWithResponses) DeleteEnrollmentApiKeyWithResponse(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*DeleteEnrollmentApiKeyResponse, error) {
+	rsp, err := c.DeleteEnrollmentApiKey(ctx, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteEnrollmentApiKeyResponse(rsp)
+}
+
+// GetEnrollmentApiKeyWithResponse request returning *GetEnrollmentApiKeyResponse
+func (c *ClientWithResponses) GetEnrollmentApiKeyWithResponse(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*GetEnrollmentApiKeyResponse, error) {
+	rsp, err := c.GetEnrollmentApiKey(ctx, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnrollmentApiKeyResponse(rsp)
+}
+
 // ListAllPackagesWithResponse request returning *ListAllPackagesResponse
 func (c *ClientWithResponses) ListAllPackagesWithResponse(ctx context.Context, params *ListAllPackagesParams, reqEditors ...RequestEditorFn) (*ListAllPackagesResponse, error) {
 	rsp, err := c.ListAllPackages(ctx, params, reqEditors...)
@@ -3854,6 +4482,15 @@ func (c *ClientWithResponses) ListAllPackagesWithResponse(ctx context.Context, p
 	return ParseListAllPackagesResponse(rsp)
 }
 
+// InstallPackageByUploadWithBodyWithResponse request with arbitrary body returning *InstallPackageByUploadResponse
+func (c *ClientWithResponses) InstallPackageByUploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InstallPackageByUploadResponse, error) {
+	rsp, err := c.InstallPackageByUploadWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInstallPackageByUploadResponse(rsp)
+}
+
 // DeletePackageWithBodyWithResponse request with arbitrary body returning *DeletePackageResponse
 func (c *ClientWithResponses) DeletePackageWithBodyWithResponse(ctx context.Context, pkgName string, pkgVersion string, params *DeletePackageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeletePackageResponse, error) {
 	rsp, err := c.DeletePackageWithBody(ctx, pkgName, pkgVersion, params, contentType, body, reqEditors...)
@@ -4141,6 +4778,24 @@ func ParseDeleteAgentPolicyResponse(rsp *http.Response) (*DeleteAgentPolicyRespo
 	return response, nil
 }
 
+// GetUninstallTokensWithResponse request returning *GetUninstallTokensResponse
+func (c *ClientWithResponses) GetUninstallTokensWithResponse(ctx context.Context, params *GetUninstallTokensParams, reqEditors ...RequestEditorFn) (*GetUninstallTokensResponse, error) {
+	rsp, err := c.GetUninstallTokens(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUninstallTokensResponse(rsp)
+}
+
+// GetUninstallTokenWithResponse request returning *GetUninstallTokenResponse
+func (c *ClientWithResponses) GetUninstallTokenWithResponse(ctx context.Context, uninstallTokenId string, reqEditors ...RequestEditorFn) (*GetUninstallTokenResponse, error) {
+	rsp, err := c.GetUninstallToken(ctx, uninstallTokenId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUninstallTokenResponse(rsp)
+}
+
 // ParseAgentPolicyInfoResponse parses an HTTP response from a AgentPolicyInfoWithResponse call
 func ParseAgentPolicyInfoResponse(rsp *http.Response) (*AgentPolicyInfoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -4251,6 +4906,112 @@ func ParseGetEnrollmentApiKeysResponse(rsp *http.Response) (*GetEnrollmentApiKey
 	return response, nil
 }
 
+// ParseCreateEnrollmentApiKeysResponse parses an HTTP response from a CreateEnrollmentApiKeysWithResponse call
+func ParseCreateEnrollmentApiKeysResponse(rsp *http.Response) (*CreateEnrollmentApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateEnrollmentApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Action string           `json:"action"`
+			Item   EnrollmentApiKey `json:"item"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteEnrollmentApiKeyResponse parses an HTTP response from a DeleteEnrollmentApiKeyWithResponse call
+func ParseDeleteEnrollmentApiKeyResponse(rsp *http.Response) (*DeleteEnrollmentApiKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteEnrollmentApiKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Action string `json:"action"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEnrollmentApiKeyResponse parses an HTTP response from a GetEnrollmentApiKeyWithResponse call
+func ParseGetEnrollmentApiKeyResponse(rsp *http.Response) (*GetEnrollmentApiKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnrollmentApiKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Item EnrollmentApiKey `json:"item"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseListAllPackagesResponse parses an HTTP response from a ListAllPackagesWithResponse call
 func ParseListAllPackagesResponse(rsp *http.Response) (*ListAllPackagesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -4284,6 +5045,47 @@ func ParseListAllPackagesResponse(rsp *http.Response) (*ListAllPackagesResponse,
 	return response, nil
 }
 
+// ParseInstallPackageByUploadResponse parses an HTTP response from a InstallPackageByUploadWithResponse call
+func ParseInstallPackageByUploadResponse(rsp *http.Response) (*InstallPackageByUploadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InstallPackageByUploadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Meta *struct {
+				InstallSource *PackageInstallSource `json:"install_source,omitempty"`
+			} `json:"_meta,omitempty"`
+			Items []struct {
+				Id   string          `json:"id"`
+				Type PackageItemType `json:"type"`
+			} `json:"items"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseDeletePackageResponse parses an HTTP response from a DeletePackageWithResponse call
 func ParseDeletePackageResponse(rsp *http.Response) (*DeletePackageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -4870,3 +5672,76 @@ func ParseUpdatePackagePolicyResponse(rsp *http.Response) (*UpdatePackagePolicyR
 
 	return response, nil
 }
+
+// ParseGetUninstallTokensResponse parses an HTTP response from a GetUninstallTokensWithResponse call
+func ParseGetUninstallTokensResponse(rsp *http.Response) (*GetUninstallTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUninstallTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items   []UninstallTokenMetadata `json:"items"`
+			Page    float32                  `json:"page"`
+			PerPage float32                  `json:"perPage"`
+			Total   float32                  `json:"total"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUninstallTokenResponse parses an HTTP response from a GetUninstallTokenWithResponse call
+func ParseGetUninstallTokenResponse(rsp *http.Response) (*GetUninstallTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUninstallTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Item UninstallToken `json:"item"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
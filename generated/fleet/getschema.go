@@ -72,6 +72,9 @@ var transformers = []TransformFunc{
 	transformInlinePackageDefinitions,
 	transformAddPackagePolicyVars,
 	transformFixPackageSearchResult,
+	transformFixEnrollmentApiKeyActions,
+	transformAddAgentPolicyProperties,
+	transformAddUninstallTokens,
 }
 
 // transformFilterPaths filters the paths in a schema down to
@@ -81,7 +84,8 @@ func transformFilterPaths(schema *Schema) {
 		"/agent_policies":                      {"post"},
 		"/agent_policies/{agentPolicyId}":      {"get", "put"},
 		"/agent_policies/delete":               {"post"},
-		"/enrollment_api_keys":                 {"get"},
+		"/enrollment_api_keys":                 {"get", "post"},
+		"/enrollment_api_keys/{keyId}":         {"get", "delete"},
 		"/fleet_server_hosts":                  {"post"},
 		"/fleet_server_hosts/{itemId}":         {"get", "put", "delete"},
 		"/outputs":                             {"post"},
@@ -89,7 +93,9 @@ func transformFilterPaths(schema *Schema) {
 		"/package_policies":                    {"post"},
 		"/package_policies/{packagePolicyId}":  {"get", "put", "delete"},
 		"/epm/packages/{pkgName}/{pkgVersion}": {"get", "put", "post", "delete"},
-		"/epm/packages":                        {"get"},
+		"/epm/packages":                        {"get", "post"},
+		"/uninstall_tokens":                    {"get"},
+		"/uninstall_tokens/{uninstallTokenId}": {"get"},
 	}
 
 	// filterKbnXsrfParameter filters out an entry if it is a kbn_xsrf parameter.
@@ -287,6 +293,24 @@ func transformInlinePackageDefinitions(schema *Schema) {
 		props.Set("items.items.properties.type.$ref", "#/components/schemas/package_item_type")
 	}
 
+	// Upload
+	{
+		uploadPath, ok := schema.Paths["/epm/packages"]
+		if !ok || uploadPath.Post == nil {
+			panic("epm upload path not found")
+		}
+		props, ok := uploadPath.Post.Responses.GetFields("200.content.application/json.schema.properties")
+		if !ok {
+			panic("properties not found")
+		}
+
+		// Definitions already moved by Post
+		props.Delete("_meta.properties.install_source")
+		props.Set("_meta.properties.install_source.$ref", "#/components/schemas/package_install_source")
+		props.Delete("items.items.properties.type")
+		props.Set("items.items.properties.type.$ref", "#/components/schemas/package_item_type")
+	}
+
 	// Move embedded objects (structs) to schemas so Go-types are generated.
 	{
 		// package_policy_request_input_stream
@@ -344,6 +368,149 @@ func transformFixPackageSearchResult(schema *Schema) {
 	properties.Delete("installationInfo")
 }
 
+// transformFixEnrollmentApiKeyActions removes the inline enum from the
+// `action` response property of the enrollment API key endpoints. The
+// generator otherwise references a type it never defines.
+func transformFixEnrollmentApiKeyActions(schema *Schema) {
+	endpoints := []*Endpoint{
+		schema.Paths["/enrollment_api_keys"].Post,
+		schema.Paths["/enrollment_api_keys/{keyId}"].Delete,
+	}
+
+	for _, endpoint := range endpoints {
+		if endpoint == nil {
+			panic("enrollment api key endpoint not found")
+		}
+		props, ok := endpoint.Responses.GetFields("200.content.application/json.schema.properties")
+		if !ok {
+			panic("properties not found")
+		}
+		props.Delete("action.enum")
+	}
+}
+
+// transformAddAgentPolicyProperties adds agent policy properties which are
+// missing from older versions of the schema.
+func transformAddAgentPolicyProperties(schema *Schema) {
+	if _, ok := schema.Components.Get("schemas.agent_policy_global_data_tags_item"); !ok {
+		schema.Components.Set("schemas.agent_policy_global_data_tags_item", Fields{
+			"type":     "object",
+			"required": []any{"name", "value"},
+			"properties": Fields{
+				"name":  Fields{"type": "string"},
+				"value": Fields{},
+			},
+		})
+	}
+
+	for _, name := range []string{"agent_policy", "agent_policy_create_request", "agent_policy_update_request"} {
+		props, ok := schema.Components.GetFields("schemas." + name + ".properties")
+		if !ok {
+			panic("properties not found")
+		}
+
+		if _, ok = props.Get("global_data_tags"); !ok {
+			props.Set("global_data_tags", Fields{
+				"type":  "array",
+				"items": Fields{"$ref": "#/components/schemas/agent_policy_global_data_tags_item"},
+			})
+		}
+		if _, ok = props.Get("supports_agentless"); !ok {
+			props.Set("supports_agentless", Fields{
+				"type":        "boolean",
+				"description": "Indicates whether the agent policy supports agentless integrations.",
+			})
+		}
+	}
+}
+
+// transformAddUninstallTokens adds the uninstall token endpoints and schemas
+// if they are missing, these were added to the Fleet API in 8.12.
+func transformAddUninstallTokens(schema *Schema) {
+	if _, ok := schema.Components.Get("schemas.uninstall_token"); !ok {
+		schema.Components.Set("schemas.uninstall_token_metadata", Fields{
+			"type":     "object",
+			"required": []any{"id", "policy_id", "created_at"},
+			"properties": Fields{
+				"id":         Fields{"type": "string"},
+				"policy_id":  Fields{"type": "string"},
+				"created_at": Fields{"type": "string"},
+			},
+		})
+		schema.Components.Set("schemas.uninstall_token", Fields{
+			"type":     "object",
+			"required": []any{"id", "token", "policy_id", "created_at"},
+			"properties": Fields{
+				"id":         Fields{"type": "string"},
+				"token":      Fields{"type": "string"},
+				"policy_id":  Fields{"type": "string"},
+				"created_at": Fields{"type": "string"},
+			},
+		})
+	}
+
+	if _, ok := schema.Paths["/uninstall_tokens"]; !ok {
+		schema.Paths["/uninstall_tokens"] = &Path{
+			Get: &Endpoint{
+				Summary:     "List metadata for latest uninstall tokens per agent policy",
+				OperationID: "get-uninstall-tokens",
+				Parameters: []Fields{
+					{"name": "policyId", "in": "query", "required": false, "description": "Partial match filtering for policy IDs", "schema": Fields{"type": "string"}},
+					{"name": "perPage", "in": "query", "required": false, "description": "The number of items to return", "schema": Fields{"type": "integer", "default": 20, "minimum": 5}},
+					{"name": "page", "in": "query", "required": false, "schema": Fields{"type": "integer", "default": 1}},
+				},
+				Responses: Fields{
+					"200": Fields{
+						"description": "OK",
+						"content": Fields{
+							"application/json": Fields{
+								"schema": Fields{
+									"type":     "object",
+									"required": []any{"items", "total", "page", "perPage"},
+									"properties": Fields{
+										"items":   Fields{"type": "array", "items": Fields{"$ref": "#/components/schemas/uninstall_token_metadata"}},
+										"total":   Fields{"type": "number"},
+										"page":    Fields{"type": "number"},
+										"perPage": Fields{"type": "number"},
+									},
+								},
+							},
+						},
+					},
+					"400": Fields{"$ref": "#/components/responses/error"},
+				},
+			},
+		}
+	}
+
+	if _, ok := schema.Paths["/uninstall_tokens/{uninstallTokenId}"]; !ok {
+		schema.Paths["/uninstall_tokens/{uninstallTokenId}"] = &Path{
+			Get: &Endpoint{
+				Summary:     "Get one decrypted uninstall token by its ID",
+				OperationID: "get-uninstall-token",
+				Parameters: []Fields{
+					{"name": "uninstallTokenId", "in": "path", "required": true, "description": "The ID of the uninstall token", "schema": Fields{"type": "string"}},
+				},
+				Responses: Fields{
+					"200": Fields{
+						"description": "OK",
+						"content": Fields{
+							"application/json": Fields{
+								"schema": Fields{
+									"type":       "object",
+									"required":   []any{"item"},
+									"properties": Fields{"item": Fields{"$ref": "#/components/schemas/uninstall_token"}},
+								},
+							},
+						},
+					},
+					"400": Fields{"$ref": "#/components/responses/error"},
+				},
+			},
+		}
+	}
+}
+
 // downloadFile will download a file from url and return the
 // bytes. If the request fails, or a non 200 error code is
 // observed in the response, an error is returned instead.
@@ -15,60 +15,193 @@ import (
 	"fmt"
 )
 
-// IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner struct for IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner
+// IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner - struct for IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner
 type IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner struct {
 	TimesliceMetricBasicMetricWithField *TimesliceMetricBasicMetricWithField
 	TimesliceMetricDocCountMetric       *TimesliceMetricDocCountMetric
 	TimesliceMetricPercentileMetric     *TimesliceMetricPercentileMetric
 }
 
-// Unmarshal JSON data into any of the pointers in the struct
+// TimesliceMetricBasicMetricWithFieldAsIndicatorPropertiesTimesliceMetricParamsMetricMetricsInner is a convenience function that returns TimesliceMetricBasicMetricWithField wrapped in IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner
+func TimesliceMetricBasicMetricWithFieldAsIndicatorPropertiesTimesliceMetricParamsMetricMetricsInner(v *TimesliceMetricBasicMetricWithField) IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner {
+	return IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner{
+		TimesliceMetricBasicMetricWithField: v,
+	}
+}
+
+// TimesliceMetricDocCountMetricAsIndicatorPropertiesTimesliceMetricParamsMetricMetricsInner is a convenience function that returns TimesliceMetricDocCountMetric wrapped in IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner
+func TimesliceMetricDocCountMetricAsIndicatorPropertiesTimesliceMetricParamsMetricMetricsInner(v *TimesliceMetricDocCountMetric) IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner {
+	return IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner{
+		TimesliceMetricDocCountMetric: v,
+	}
+}
+
+// TimesliceMetricPercentileMetricAsIndicatorPropertiesTimesliceMetricParamsMetricMetricsInner is a convenience function that returns TimesliceMetricPercentileMetric wrapped in IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner
+func TimesliceMetricPercentileMetricAsIndicatorPropertiesTimesliceMetricParamsMetricMetricsInner(v *TimesliceMetricPercentileMetric) IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner {
+	return IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner{
+		TimesliceMetricPercentileMetric: v,
+	}
+}
+
+// Unmarshal JSON data into one of the pointers in the struct
 func (dst *IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner) UnmarshalJSON(data []byte) error {
 	var err error
-	// try to unmarshal JSON data into TimesliceMetricBasicMetricWithField
-	err = json.Unmarshal(data, &dst.TimesliceMetricBasicMetricWithField)
-	if err == nil {
-		jsonTimesliceMetricBasicMetricWithField, _ := json.Marshal(dst.TimesliceMetricBasicMetricWithField)
-		if string(jsonTimesliceMetricBasicMetricWithField) == "{}" { // empty struct
-			dst.TimesliceMetricBasicMetricWithField = nil
+	// use discriminator value to speed up the lookup
+	var jsonDict map[string]interface{}
+	err = newStrictDecoder(data).Decode(&jsonDict)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal JSON into map for the discriminator lookup")
+	}
+
+	// check if the discriminator value is 'avg'
+	if jsonDict["aggregation"] == "avg" {
+		// try to unmarshal JSON data into TimesliceMetricBasicMetricWithField
+		err = json.Unmarshal(data, &dst.TimesliceMetricBasicMetricWithField)
+		if err == nil {
+			return nil // data stored in dst.TimesliceMetricBasicMetricWithField, return on the first match
 		} else {
+			dst.TimesliceMetricBasicMetricWithField = nil
+			return fmt.Errorf("failed to unmarshal IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner as TimesliceMetricBasicMetricWithField: %s", err.Error())
+		}
+	}
+
+	// check if the discriminator value is 'cardinality'
+	if jsonDict["aggregation"] == "cardinality" {
+		// try to unmarshal JSON data into TimesliceMetricBasicMetricWithField
+		err = json.Unmarshal(data, &dst.TimesliceMetricBasicMetricWithField)
+		if err == nil {
 			return nil // data stored in dst.TimesliceMetricBasicMetricWithField, return on the first match
+		} else {
+			dst.TimesliceMetricBasicMetricWithField = nil
+			return fmt.Errorf("failed to unmarshal IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner as TimesliceMetricBasicMetricWithField: %s", err.Error())
 		}
-	} else {
-		dst.TimesliceMetricBasicMetricWithField = nil
 	}
 
-	// try to unmarshal JSON data into TimesliceMetricDocCountMetric
-	err = json.Unmarshal(data, &dst.TimesliceMetricDocCountMetric)
-	if err == nil {
-		jsonTimesliceMetricDocCountMetric, _ := json.Marshal(dst.TimesliceMetricDocCountMetric)
-		if string(jsonTimesliceMetricDocCountMetric) == "{}" { // empty struct
+	// check if the discriminator value is 'doc_count'
+	if jsonDict["aggregation"] == "doc_count" {
+		// try to unmarshal JSON data into TimesliceMetricDocCountMetric
+		err = json.Unmarshal(data, &dst.TimesliceMetricDocCountMetric)
+		if err == nil {
+			return nil // data stored in dst.TimesliceMetricDocCountMetric, return on the first match
+		} else {
 			dst.TimesliceMetricDocCountMetric = nil
+			return fmt.Errorf("failed to unmarshal IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner as TimesliceMetricDocCountMetric: %s", err.Error())
+		}
+	}
+
+	// check if the discriminator value is 'last_value'
+	if jsonDict["aggregation"] == "last_value" {
+		// try to unmarshal JSON data into TimesliceMetricBasicMetricWithField
+		err = json.Unmarshal(data, &dst.TimesliceMetricBasicMetricWithField)
+		if err == nil {
+			return nil // data stored in dst.TimesliceMetricBasicMetricWithField, return on the first match
 		} else {
-			return nil // data stored in dst.TimesliceMetricDocCountMetric, return on the first match
+			dst.TimesliceMetricBasicMetricWithField = nil
+			return fmt.Errorf("failed to unmarshal IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner as TimesliceMetricBasicMetricWithField: %s", err.Error())
+		}
+	}
+
+	// check if the discriminator value is 'max'
+	if jsonDict["aggregation"] == "max" {
+		// try to unmarshal JSON data into TimesliceMetricBasicMetricWithField
+		err = json.Unmarshal(data, &dst.TimesliceMetricBasicMetricWithField)
+		if err == nil {
+			return nil // data stored in dst.TimesliceMetricBasicMetricWithField, return on the first match
+		} else {
+			dst.TimesliceMetricBasicMetricWithField = nil
+			return fmt.Errorf("failed to unmarshal IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner as TimesliceMetricBasicMetricWithField: %s", err.Error())
+		}
+	}
+
+	// check if the discriminator value is 'min'
+	if jsonDict["aggregation"] == "min" {
+		// try to unmarshal JSON data into TimesliceMetricBasicMetricWithField
+		err = json.Unmarshal(data, &dst.TimesliceMetricBasicMetricWithField)
+		if err == nil {
+			return nil // data stored in dst.TimesliceMetricBasicMetricWithField, return on the first match
+		} else {
+			dst.TimesliceMetricBasicMetricWithField = nil
+			return fmt.Errorf("failed to unmarshal IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner as TimesliceMetricBasicMetricWithField: %s", err.Error())
 		}
-	} else {
-		dst.TimesliceMetricDocCountMetric = nil
 	}
 
-	// try to unmarshal JSON data into TimesliceMetricPercentileMetric
-	err = json.Unmarshal(data, &dst.TimesliceMetricPercentileMetric)
-	if err == nil {
-		jsonTimesliceMetricPercentileMetric, _ := json.Marshal(dst.TimesliceMetricPercentileMetric)
-		if string(jsonTimesliceMetricPercentileMetric) == "{}" { // empty struct
+	// check if the discriminator value is 'percentile'
+	if jsonDict["aggregation"] == "percentile" {
+		// try to unmarshal JSON data into TimesliceMetricPercentileMetric
+		err = json.Unmarshal(data, &dst.TimesliceMetricPercentileMetric)
+		if err == nil {
+			return nil // data stored in dst.TimesliceMetricPercentileMetric, return on the first match
+		} else {
 			dst.TimesliceMetricPercentileMetric = nil
+			return fmt.Errorf("failed to unmarshal IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner as TimesliceMetricPercentileMetric: %s", err.Error())
+		}
+	}
+
+	// check if the discriminator value is 'std_deviation'
+	if jsonDict["aggregation"] == "std_deviation" {
+		// try to unmarshal JSON data into TimesliceMetricBasicMetricWithField
+		err = json.Unmarshal(data, &dst.TimesliceMetricBasicMetricWithField)
+		if err == nil {
+			return nil // data stored in dst.TimesliceMetricBasicMetricWithField, return on the first match
+		} else {
+			dst.TimesliceMetricBasicMetricWithField = nil
+			return fmt.Errorf("failed to unmarshal IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner as TimesliceMetricBasicMetricWithField: %s", err.Error())
+		}
+	}
+
+	// check if the discriminator value is 'sum'
+	if jsonDict["aggregation"] == "sum" {
+		// try to unmarshal JSON data into TimesliceMetricBasicMetricWithField
+		err = json.Unmarshal(data, &dst.TimesliceMetricBasicMetricWithField)
+		if err == nil {
+			return nil // data stored in dst.TimesliceMetricBasicMetricWithField, return on the first match
+		} else {
+			dst.TimesliceMetricBasicMetricWithField = nil
+			return fmt.Errorf("failed to unmarshal IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner as TimesliceMetricBasicMetricWithField: %s", err.Error())
+		}
+	}
+
+	// check if the discriminator value is 'timeslice_metric_basic_metric_with_field'
+	if jsonDict["aggregation"] == "timeslice_metric_basic_metric_with_field" {
+		// try to unmarshal JSON data into TimesliceMetricBasicMetricWithField
+		err = json.Unmarshal(data, &dst.TimesliceMetricBasicMetricWithField)
+		if err == nil {
+			return nil // data stored in dst.TimesliceMetricBasicMetricWithField, return on the first match
 		} else {
+			dst.TimesliceMetricBasicMetricWithField = nil
+			return fmt.Errorf("failed to unmarshal IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner as TimesliceMetricBasicMetricWithField: %s", err.Error())
+		}
+	}
+
+	// check if the discriminator value is 'timeslice_metric_doc_count_metric'
+	if jsonDict["aggregation"] == "timeslice_metric_doc_count_metric" {
+		// try to unmarshal JSON data into TimesliceMetricDocCountMetric
+		err = json.Unmarshal(data, &dst.TimesliceMetricDocCountMetric)
+		if err == nil {
+			return nil // data stored in dst.TimesliceMetricDocCountMetric, return on the first match
+		} else {
+			dst.TimesliceMetricDocCountMetric = nil
+			return fmt.Errorf("failed to unmarshal IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner as TimesliceMetricDocCountMetric: %s", err.Error())
+		}
+	}
+
+	// check if the discriminator value is 'timeslice_metric_percentile_metric'
+	if jsonDict["aggregation"] == "timeslice_metric_percentile_metric" {
+		// try to unmarshal JSON data into TimesliceMetricPercentileMetric
+		err = json.Unmarshal(data, &dst.TimesliceMetricPercentileMetric)
+		if err == nil {
 			return nil // data stored in dst.TimesliceMetricPercentileMetric, return on the first match
+		} else {
+			dst.TimesliceMetricPercentileMetric = nil
+			return fmt.Errorf("failed to unmarshal IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner as TimesliceMetricPercentileMetric: %s", err.Error())
 		}
-	} else {
-		dst.TimesliceMetricPercentileMetric = nil
 	}
 
-	return fmt.Errorf("data failed to match schemas in anyOf(IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner)")
+	return nil
 }
 
 // Marshal data from the first non-nil pointers in the struct to JSON
-func (src *IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner) MarshalJSON() ([]byte, error) {
+func (src IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner) MarshalJSON() ([]byte, error) {
 	if src.TimesliceMetricBasicMetricWithField != nil {
 		return json.Marshal(&src.TimesliceMetricBasicMetricWithField)
 	}
@@ -81,7 +214,28 @@ func (src *IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner) MarshalJS
 		return json.Marshal(&src.TimesliceMetricPercentileMetric)
 	}
 
-	return nil, nil // no data in anyOf schemas
+	return nil, nil // no data in oneOf schemas
+}
+
+// Get the actual instance
+func (obj *IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner) GetActualInstance() interface{} {
+	if obj == nil {
+		return nil
+	}
+	if obj.TimesliceMetricBasicMetricWithField != nil {
+		return obj.TimesliceMetricBasicMetricWithField
+	}
+
+	if obj.TimesliceMetricDocCountMetric != nil {
+		return obj.TimesliceMetricDocCountMetric
+	}
+
+	if obj.TimesliceMetricPercentileMetric != nil {
+		return obj.TimesliceMetricPercentileMetric
+	}
+
+	// all schemas are nil
+	return nil
 }
 
 type NullableIndicatorPropertiesTimesliceMetricParamsMetricMetricsInner struct {
@@ -23,6 +23,8 @@ type Settings struct {
 	SyncDelay *string `json:"syncDelay,omitempty"`
 	// Configure how often the transform runs, default 1m
 	Frequency *string `json:"frequency,omitempty"`
+	// Prevents the underlying ES transform from attempting to backfill data on start, which can sometimes be resource-intensive or time-consuming and unnecessary
+	PreventInitialBackfill *bool `json:"preventInitialBackfill,omitempty"`
 }
 
 // NewSettings instantiates a new Settings object
@@ -106,6 +108,38 @@ func (o *Settings) SetFrequency(v string) {
 	o.Frequency = &v
 }
 
+// GetPreventInitialBackfill returns the PreventInitialBackfill field value if set, zero value otherwise.
+func (o *Settings) GetPreventInitialBackfill() bool {
+	if o == nil || IsNil(o.PreventInitialBackfill) {
+		var ret bool
+		return ret
+	}
+	return *o.PreventInitialBackfill
+}
+
+// GetPreventInitialBackfillOk returns a tuple with the PreventInitialBackfill field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Settings) GetPreventInitialBackfillOk() (*bool, bool) {
+	if o == nil || IsNil(o.PreventInitialBackfill) {
+		return nil, false
+	}
+	return o.PreventInitialBackfill, true
+}
+
+// HasPreventInitialBackfill returns a boolean if a field has been set.
+func (o *Settings) HasPreventInitialBackfill() bool {
+	if o != nil && !IsNil(o.PreventInitialBackfill) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreventInitialBackfill gets a reference to the given bool and assigns it to the PreventInitialBackfill field.
+func (o *Settings) SetPreventInitialBackfill(v bool) {
+	o.PreventInitialBackfill = &v
+}
+
 func (o Settings) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -122,6 +156,9 @@ func (o Settings) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Frequency) {
 		toSerialize["frequency"] = o.Frequency
 	}
+	if !IsNil(o.PreventInitialBackfill) {
+		toSerialize["preventInitialBackfill"] = o.PreventInitialBackfill
+	}
 	return toSerialize, nil
 }
 
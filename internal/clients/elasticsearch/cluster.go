@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/elastic/go-elasticsearch/v7/esapi"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
@@ -136,14 +137,16 @@ func PutSlm(ctx context.Context, apiClient *clients.ApiClient, slm *models.Snaps
 	return diags
 }
 
-func GetSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string) (*models.SnapshotPolicy, diag.Diagnostics) {
+// GetSlm returns the SLM policy with its last executions and stats.
+func GetSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string) (*models.SnapshotPolicyInfo, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	esClient, err := apiClient.GetESClient()
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
 	req := esClient.SlmGetLifecycle.WithPolicyID(slmName)
-	res, err := esClient.SlmGetLifecycle(req, esClient.SlmGetLifecycle.WithContext(ctx))
+	// The execution times are only returned as strings in human readable responses
+	res, err := esClient.SlmGetLifecycle(req, esClient.SlmGetLifecycle.WithHuman(), esClient.SlmGetLifecycle.WithContext(ctx))
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
@@ -154,15 +157,12 @@ func GetSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string) (
 	if diags := utils.CheckError(res, "Unable to get SLM policy from ES API"); diags.HasError() {
 		return nil, diags
 	}
-	type SlmResponse = map[string]struct {
-		Policy models.SnapshotPolicy `json:"policy"`
-	}
-	var slmResponse SlmResponse
+	var slmResponse map[string]models.SnapshotPolicyInfo
 	if err := json.NewDecoder(res.Body).Decode(&slmResponse); err != nil {
 		return nil, diag.FromErr(err)
 	}
 	if slm, ok := slmResponse[slmName]; ok {
-		return &slm.Policy, diags
+		return &slm, diags
 	}
 	diags = append(diags, diag.Diagnostic{
 		Severity: diag.Error,
@@ -190,6 +190,300 @@ func DeleteSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string
 	return diags
 }
 
+// ExecuteSlm immediately creates a snapshot according to the SLM policy, returning the name of the snapshot.
+func ExecuteSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string) (string, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	res, err := esClient.SlmExecuteLifecycle(slmName, esClient.SlmExecuteLifecycle.WithContext(ctx))
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to execute SLM policy: %s", slmName)); diags.HasError() {
+		return "", diags
+	}
+
+	var executeResponse struct {
+		SnapshotName string `json:"snapshot_name"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&executeResponse); err != nil {
+		return "", diag.FromErr(err)
+	}
+	return executeResponse.SnapshotName, nil
+}
+
+// GetSlmStatus returns the operation mode of SLM: RUNNING, STOPPING or STOPPED.
+func GetSlmStatus(ctx context.Context, apiClient *clients.ApiClient) (string, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	res, err := esClient.SlmGetStatus(esClient.SlmGetStatus.WithContext(ctx))
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get the SLM status"); diags.HasError() {
+		return "", diags
+	}
+
+	var statusResponse struct {
+		OperationMode string `json:"operation_mode"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&statusResponse); err != nil {
+		return "", diag.FromErr(err)
+	}
+	return statusResponse.OperationMode, nil
+}
+
+func StartSlm(ctx context.Context, apiClient *clients.ApiClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := esClient.SlmStart(esClient.SlmStart.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to start SLM"); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+func StopSlm(ctx context.Context, apiClient *clients.ApiClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := esClient.SlmStop(esClient.SlmStop.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to stop SLM"); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+// CreateSnapshot starts a snapshot. The snapshot is only returned when waiting for its completion.
+func CreateSnapshot(ctx context.Context, apiClient *clients.ApiClient, repository, name string, config *models.SnapshotPolicyConfig, waitForCompletion bool) (*models.Snapshot, diag.Diagnostics) {
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := esClient.Snapshot.Create(
+		repository,
+		name,
+		esClient.Snapshot.Create.WithBody(bytes.NewReader(configBytes)),
+		esClient.Snapshot.Create.WithWaitForCompletion(waitForCompletion),
+		esClient.Snapshot.Create.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to create the snapshot: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+	if !waitForCompletion {
+		return nil, nil
+	}
+
+	var snapshotResponse struct {
+		Snapshot models.Snapshot `json:"snapshot"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&snapshotResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &snapshotResponse.Snapshot, nil
+}
+
+func GetSnapshot(ctx context.Context, apiClient *clients.ApiClient, repository, name string) (*models.Snapshot, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := esClient.Snapshot.Get(repository, []string{name}, esClient.Snapshot.Get.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get the snapshot: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+
+	var snapshotsResponse struct {
+		Snapshots []models.Snapshot `json:"snapshots"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&snapshotsResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, snapshot := range snapshotsResponse.Snapshots {
+		if snapshot.Snapshot == name {
+			return &snapshot, nil
+		}
+	}
+	return nil, nil
+}
+
+func DeleteSnapshot(ctx context.Context, apiClient *clients.ApiClient, repository, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := esClient.Snapshot.Delete(repository, name, esClient.Snapshot.Delete.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return diags
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to delete the snapshot: %s", name)); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+// RestoreSnapshot starts restoring a snapshot. The restore details are only returned when waiting for its completion.
+func RestoreSnapshot(ctx context.Context, apiClient *clients.ApiClient, repository, name string, restore *models.SnapshotRestore, waitForCompletion bool) (*models.SnapshotRestoreInfo, diag.Diagnostics) {
+	restoreBytes, err := json.Marshal(restore)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := esClient.Snapshot.Restore(
+		repository,
+		name,
+		esClient.Snapshot.Restore.WithBody(bytes.NewReader(restoreBytes)),
+		esClient.Snapshot.Restore.WithWaitForCompletion(waitForCompletion),
+		esClient.Snapshot.Restore.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to restore the snapshot: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+	if !waitForCompletion {
+		return nil, nil
+	}
+
+	var restoreResponse struct {
+		Snapshot models.SnapshotRestoreInfo `json:"snapshot"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&restoreResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &restoreResponse.Snapshot, nil
+}
+
+func MountSearchableSnapshot(ctx context.Context, apiClient *clients.ApiClient, repository, snapshot, storage string, mount *models.SearchableSnapshotMount, waitForCompletion bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	mountBytes, err := json.Marshal(mount)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := esClient.SearchableSnapshotsMount(
+		repository,
+		snapshot,
+		bytes.NewReader(mountBytes),
+		esClient.SearchableSnapshotsMount.WithStorage(storage),
+		esClient.SearchableSnapshotsMount.WithWaitForCompletion(waitForCompletion),
+		esClient.SearchableSnapshotsMount.WithContext(ctx),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to mount the index %s of the snapshot: %s", mount.Index, snapshot)); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+// VerifySnapshotRepository checks the repository is accessible, returning the nodes which can access it.
+func VerifySnapshotRepository(ctx context.Context, apiClient *clients.ApiClient, name string) (map[string]models.SnapshotRepositoryVerifyNode, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := esClient.Snapshot.VerifyRepository(name, esClient.Snapshot.VerifyRepository.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to verify the snapshot repository: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+
+	var verifyResponse struct {
+		Nodes map[string]models.SnapshotRepositoryVerifyNode `json:"nodes"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&verifyResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return verifyResponse.Nodes, nil
+}
+
+// AnalyzeSnapshotRepository writes, reads and deletes test blobs in the repository to check it behaves correctly under load.
+func AnalyzeSnapshotRepository(ctx context.Context, apiClient *clients.ApiClient, name string, params *models.AnalyzeSnapshotRepositoryParams) (*models.SnapshotRepositoryAnalysis, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	opts := []func(*esapi.SnapshotRepositoryAnalyzeRequest){
+		esClient.Snapshot.RepositoryAnalyze.WithContext(ctx),
+		esClient.Snapshot.RepositoryAnalyze.WithBlobCount(params.BlobCount),
+		esClient.Snapshot.RepositoryAnalyze.WithConcurrency(params.Concurrency),
+		esClient.Snapshot.RepositoryAnalyze.WithReadNodeCount(params.ReadNodeCount),
+		esClient.Snapshot.RepositoryAnalyze.WithEarlyReadNodeCount(params.EarlyReadNodeCount),
+		esClient.Snapshot.RepositoryAnalyze.WithMaxBlobSize(params.MaxBlobSize),
+		esClient.Snapshot.RepositoryAnalyze.WithMaxTotalDataSize(params.MaxTotalDataSize),
+		esClient.Snapshot.RepositoryAnalyze.WithTimeout(params.Timeout),
+	}
+	if params.Seed != nil {
+		opts = append(opts, esClient.Snapshot.RepositoryAnalyze.WithSeed(*params.Seed))
+	}
+	res, err := esClient.Snapshot.RepositoryAnalyze(name, opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to analyze the snapshot repository: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+
+	var analysis models.SnapshotRepositoryAnalysis
+	if err := json.NewDecoder(res.Body).Decode(&analysis); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &analysis, nil
+}
+
 func PutSettings(ctx context.Context, apiClient *clients.ApiClient, settings map[string]interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	settingsBytes, err := json.Marshal(settings)
@@ -39,12 +39,43 @@ func PutIlm(ctx context.Context, apiClient *clients.ApiClient, policy *models.Po
 
 func GetIlm(ctx context.Context, apiClient *clients.ApiClient, policyName string) (*models.PolicyDefinition, diag.Diagnostics) {
 	var diags diag.Diagnostics
+	ilm, diags := getIlms(ctx, apiClient, policyName)
+	if ilm == nil || diags.HasError() {
+		return nil, diags
+	}
+
+	if ilm, ok := ilm[policyName]; ok {
+		return &ilm, diags
+	}
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Unable to find a ILM policy in the cluster",
+		Detail:   fmt.Sprintf(`Unable to find "%s" ILM policy in the cluster`, policyName),
+	})
+	return nil, diags
+}
+
+// GetIlms returns all the ILM policies of the cluster, by name.
+func GetIlms(ctx context.Context, apiClient *clients.ApiClient) (map[string]models.PolicyDefinition, diag.Diagnostics) {
+	ilm, diags := getIlms(ctx, apiClient, "")
+	if diags.HasError() {
+		return nil, diags
+	}
+	return ilm, nil
+}
+
+func getIlms(ctx context.Context, apiClient *clients.ApiClient, policyName string) (map[string]models.PolicyDefinition, diag.Diagnostics) {
 	esClient, err := apiClient.GetESClient()
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
-	req := esClient.ILM.GetLifecycle.WithPolicy(policyName)
-	res, err := esClient.ILM.GetLifecycle(req, esClient.ILM.GetLifecycle.WithContext(ctx))
+	opts := []func(*esapi.ILMGetLifecycleRequest){
+		esClient.ILM.GetLifecycle.WithContext(ctx),
+	}
+	if policyName != "" {
+		opts = append(opts, esClient.ILM.GetLifecycle.WithPolicy(policyName))
+	}
+	res, err := esClient.ILM.GetLifecycle(opts...)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
@@ -61,16 +92,7 @@ func GetIlm(ctx context.Context, apiClient *clients.ApiClient, policyName string
 	if err := json.NewDecoder(res.Body).Decode(&ilm); err != nil {
 		return nil, diag.FromErr(err)
 	}
-
-	if ilm, ok := ilm[policyName]; ok {
-		return &ilm, diags
-	}
-	diags = append(diags, diag.Diagnostic{
-		Severity: diag.Error,
-		Summary:  "Unable to find a ILM policy in the cluster",
-		Detail:   fmt.Sprintf(`Unable to find "%s" ILM policy in the cluster`, policyName),
-	})
-	return nil, diags
+	return ilm, nil
 }
 
 func DeleteIlm(ctx context.Context, apiClient *clients.ApiClient, policyName string) diag.Diagnostics {
@@ -91,6 +113,84 @@ func DeleteIlm(ctx context.Context, apiClient *clients.ApiClient, policyName str
 	return diags
 }
 
+// ExplainIlm returns the lifecycle state of the indices matching the index pattern, by index name.
+func ExplainIlm(ctx context.Context, apiClient *clients.ApiClient, index string, onlyManaged, onlyErrors bool) (map[string]models.IlmExplainIndex, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	opts := []func(*esapi.ILMExplainLifecycleRequest){
+		esClient.ILM.ExplainLifecycle.WithContext(ctx),
+	}
+	if onlyManaged {
+		opts = append(opts, esClient.ILM.ExplainLifecycle.WithOnlyManaged(true))
+	}
+	if onlyErrors {
+		opts = append(opts, esClient.ILM.ExplainLifecycle.WithOnlyErrors(true))
+	}
+	res, err := esClient.ILM.ExplainLifecycle(index, opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to explain the lifecycle of the indices: %s", index)); diags.HasError() {
+		return nil, diags
+	}
+
+	var explain models.IlmExplainResponse
+	if err := json.NewDecoder(res.Body).Decode(&explain); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return explain.Indices, nil
+}
+
+// RetryIlm retries the failed lifecycle step of the index.
+func RetryIlm(ctx context.Context, apiClient *clients.ApiClient, index string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := esClient.ILM.Retry(index, esClient.ILM.Retry.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to retry the failed lifecycle step of the index: %s", index)); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+// MoveIlmToStep moves the index from its current lifecycle step to the next step.
+func MoveIlmToStep(ctx context.Context, apiClient *clients.ApiClient, index string, currentStep, nextStep models.IlmStepKey) diag.Diagnostics {
+	var diags diag.Diagnostics
+	body, err := json.Marshal(map[string]interface{}{
+		"current_step": currentStep,
+		"next_step":    nextStep,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := esClient.ILM.MoveToStep(
+		index,
+		esClient.ILM.MoveToStep.WithBody(bytes.NewReader(body)),
+		esClient.ILM.MoveToStep.WithContext(ctx),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to move the index to the lifecycle step: %s", index)); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
 func PutComponentTemplate(ctx context.Context, apiClient *clients.ApiClient, template *models.ComponentTemplate) diag.Diagnostics {
 	var diags diag.Diagnostics
 	templateBytes, err := json.Marshal(template)
@@ -116,11 +216,31 @@ func PutComponentTemplate(ctx context.Context, apiClient *clients.ApiClient, tem
 
 func GetComponentTemplate(ctx context.Context, apiClient *clients.ApiClient, templateName string) (*models.ComponentTemplateResponse, diag.Diagnostics) {
 	var diags diag.Diagnostics
+	componentTemplates, diags := GetComponentTemplates(ctx, apiClient, templateName)
+	if componentTemplates == nil || diags.HasError() {
+		return nil, diags
+	}
+
+	// we requested only 1 template
+	if len(componentTemplates.ComponentTemplates) != 1 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Wrong number of templates returned",
+			Detail:   fmt.Sprintf("Elasticsearch API returned %d when requested '%s' component template.", len(componentTemplates.ComponentTemplates), templateName),
+		})
+		return nil, diags
+	}
+	tpl := componentTemplates.ComponentTemplates[0]
+	return &tpl, diags
+}
+
+// GetComponentTemplates returns the component templates matching the name, which may contain wildcards.
+func GetComponentTemplates(ctx context.Context, apiClient *clients.ApiClient, name string) (*models.ComponentTemplatesResponse, diag.Diagnostics) {
 	esClient, err := apiClient.GetESClient()
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
-	req := esClient.Cluster.GetComponentTemplate.WithName(templateName)
+	req := esClient.Cluster.GetComponentTemplate.WithName(name)
 	res, err := esClient.Cluster.GetComponentTemplate(req, esClient.Cluster.GetComponentTemplate.WithContext(ctx))
 	if err != nil {
 		return nil, diag.FromErr(err)
@@ -137,18 +257,7 @@ func GetComponentTemplate(ctx context.Context, apiClient *clients.ApiClient, tem
 	if err := json.NewDecoder(res.Body).Decode(&componentTemplates); err != nil {
 		return nil, diag.FromErr(err)
 	}
-
-	// we requested only 1 template
-	if len(componentTemplates.ComponentTemplates) != 1 {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Wrong number of templates returned",
-			Detail:   fmt.Sprintf("Elasticsearch API returned %d when requested '%s' component template.", len(componentTemplates.ComponentTemplates), templateName),
-		})
-		return nil, diags
-	}
-	tpl := componentTemplates.ComponentTemplates[0]
-	return &tpl, diags
+	return &componentTemplates, nil
 }
 
 func DeleteComponentTemplate(ctx context.Context, apiClient *clients.ApiClient, templateName string) diag.Diagnostics {
@@ -193,11 +302,64 @@ func PutIndexTemplate(ctx context.Context, apiClient *clients.ApiClient, templat
 
 func GetIndexTemplate(ctx context.Context, apiClient *clients.ApiClient, templateName string) (*models.IndexTemplateResponse, diag.Diagnostics) {
 	var diags diag.Diagnostics
+	indexTemplates, diags := GetIndexTemplates(ctx, apiClient, templateName)
+	if indexTemplates == nil || diags.HasError() {
+		return nil, diags
+	}
+
+	// we requested only 1 template
+	if len(indexTemplates.IndexTemplates) != 1 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Wrong number of templates returned",
+			Detail:   fmt.Sprintf("Elasticsearch API returned %d when requested '%s' template.", len(indexTemplates.IndexTemplates), templateName),
+		})
+		return nil, diags
+	}
+	tpl := indexTemplates.IndexTemplates[0]
+	return &tpl, diags
+}
+
+// SimulateIndexTemplate returns the configuration the index would get from the existing index templates, and from the
+// unsaved template if given.
+func SimulateIndexTemplate(ctx context.Context, apiClient *clients.ApiClient, indexName string, template *models.IndexTemplate) (*models.SimulatedIndexTemplate, diag.Diagnostics) {
 	esClient, err := apiClient.GetESClient()
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
-	req := esClient.Indices.GetIndexTemplate.WithName(templateName)
+	opts := []func(*esapi.IndicesSimulateIndexTemplateRequest){
+		esClient.Indices.SimulateIndexTemplate.WithContext(ctx),
+	}
+	if template != nil {
+		templateBytes, err := json.Marshal(template)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		opts = append(opts, esClient.Indices.SimulateIndexTemplate.WithBody(bytes.NewReader(templateBytes)))
+	}
+	res, err := esClient.Indices.SimulateIndexTemplate(indexName, opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to simulate the index templates for the index: %s", indexName)); diags.HasError() {
+		return nil, diags
+	}
+
+	var simulated models.SimulatedIndexTemplate
+	if err := json.NewDecoder(res.Body).Decode(&simulated); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &simulated, nil
+}
+
+// GetIndexTemplates returns the index templates matching the name, which may contain wildcards.
+func GetIndexTemplates(ctx context.Context, apiClient *clients.ApiClient, name string) (*models.IndexTemplatesResponse, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	req := esClient.Indices.GetIndexTemplate.WithName(name)
 	res, err := esClient.Indices.GetIndexTemplate(req, esClient.Indices.GetIndexTemplate.WithContext(ctx))
 	if err != nil {
 		return nil, diag.FromErr(err)
@@ -214,18 +376,7 @@ func GetIndexTemplate(ctx context.Context, apiClient *clients.ApiClient, templat
 	if err := json.NewDecoder(res.Body).Decode(&indexTemplates); err != nil {
 		return nil, diag.FromErr(err)
 	}
-
-	// we requested only 1 template
-	if len(indexTemplates.IndexTemplates) != 1 {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Wrong number of templates returned",
-			Detail:   fmt.Sprintf("Elasticsearch API returned %d when requested '%s' template.", len(indexTemplates.IndexTemplates), templateName),
-		})
-		return nil, diags
-	}
-	tpl := indexTemplates.IndexTemplates[0]
-	return &tpl, diags
+	return &indexTemplates, nil
 }
 
 func DeleteIndexTemplate(ctx context.Context, apiClient *clients.ApiClient, templateName string) diag.Diagnostics {
@@ -302,7 +453,17 @@ func DeleteIndex(ctx context.Context, apiClient *clients.ApiClient, name string)
 
 func GetIndex(ctx context.Context, apiClient *clients.ApiClient, name string) (*models.Index, diag.Diagnostics) {
 	var diags diag.Diagnostics
+	indices, diags := GetIndices(ctx, apiClient, name)
+	// if there is no index found, return the empty struct, which should force the creation of the index
+	if indices == nil || diags.HasError() {
+		return nil, diags
+	}
+	index := indices[name]
+	return &index, diags
+}
 
+// GetIndices returns the indices matching the name, which may contain wildcards, by name.
+func GetIndices(ctx context.Context, apiClient *clients.ApiClient, name string) (map[string]models.Index, diag.Diagnostics) {
 	esClient, err := apiClient.GetESClient()
 	if err != nil {
 		return nil, diag.FromErr(err)
@@ -313,7 +474,6 @@ func GetIndex(ctx context.Context, apiClient *clients.ApiClient, name string) (*
 		return nil, diag.FromErr(err)
 	}
 	defer res.Body.Close()
-	// if there is no index found, return the empty struct, which should force the creation of the index
 	if res.StatusCode == http.StatusNotFound {
 		return nil, nil
 	}
@@ -326,8 +486,7 @@ func GetIndex(ctx context.Context, apiClient *clients.ApiClient, name string) (*
 	if err := json.NewDecoder(res.Body).Decode(&indices); err != nil {
 		return nil, diag.FromErr(err)
 	}
-	index := indices[name]
-	return &index, diags
+	return indices, nil
 }
 
 func DeleteIndexAlias(ctx context.Context, apiClient *clients.ApiClient, index string, aliases []string) diag.Diagnostics {
@@ -409,6 +568,171 @@ func UpdateIndexMappings(ctx context.Context, apiClient *clients.ApiClient, inde
 	return diags
 }
 
+func CloseIndex(ctx context.Context, apiClient *clients.ApiClient, index string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := esClient.Indices.Close([]string{index}, esClient.Indices.Close.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to close the index: %s", index)); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+func OpenIndex(ctx context.Context, apiClient *clients.ApiClient, index, waitForActiveShards string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	opts := []func(*esapi.IndicesOpenRequest){
+		esClient.Indices.Open.WithContext(ctx),
+	}
+	if waitForActiveShards != "" {
+		opts = append(opts, esClient.Indices.Open.WithWaitForActiveShards(waitForActiveShards))
+	}
+	res, err := esClient.Indices.Open([]string{index}, opts...)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to open the index: %s", index)); diags.HasError() {
+		return diags
+	}
+
+	var response struct {
+		ShardsAcknowledged bool `json:"shards_acknowledged"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return diag.FromErr(err)
+	}
+	if !response.ShardsAcknowledged {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The index %s has been opened before the required shard copies were active", index),
+			Detail:   "The index is open, but the shard copies required by wait_for_active_shards didn't become active before the timeout. The index may not be fully available yet.",
+		})
+	}
+	return diags
+}
+
+// GetIndicesWithAlias returns the alias properties of each index the alias points to, or nil if the alias doesn't exist.
+func GetIndicesWithAlias(ctx context.Context, apiClient *clients.ApiClient, aliasName string) (map[string]models.IndexAlias, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := esClient.Indices.GetAlias(
+		esClient.Indices.GetAlias.WithName(aliasName),
+		esClient.Indices.GetAlias.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get the alias: %s", aliasName)); diags.HasError() {
+		return nil, diags
+	}
+
+	indices := make(map[string]struct {
+		Aliases map[string]models.IndexAlias `json:"aliases"`
+	})
+	if err := json.NewDecoder(res.Body).Decode(&indices); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	result := make(map[string]models.IndexAlias, len(indices))
+	for indexName, index := range indices {
+		if alias, ok := index.Aliases[aliasName]; ok {
+			alias.Name = aliasName
+			result[indexName] = alias
+		}
+	}
+	return result, nil
+}
+
+func UpdateIndexAliases(ctx context.Context, apiClient *clients.ApiClient, actions []models.IndexAliasAction) diag.Diagnostics {
+	var diags diag.Diagnostics
+	actionsBytes, err := json.Marshal(map[string]interface{}{"actions": actions})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := esClient.Indices.UpdateAliases(bytes.NewReader(actionsBytes), esClient.Indices.UpdateAliases.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to update index aliases"); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+// StartReindex copies the documents of the source index into the destination index in the background, and returns
+// the ID of the task tracking the copy.
+func StartReindex(ctx context.Context, apiClient *clients.ApiClient, source, dest string) (string, diag.Diagnostics) {
+	reindexBytes, err := json.Marshal(map[string]interface{}{
+		"source": map[string]interface{}{"index": source},
+		"dest":   map[string]interface{}{"index": dest},
+	})
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	res, err := esClient.Reindex(bytes.NewReader(reindexBytes), esClient.Reindex.WithWaitForCompletion(false), esClient.Reindex.WithContext(ctx))
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to reindex '%s' into '%s'", source, dest)); diags.HasError() {
+		return "", diags
+	}
+
+	var task struct {
+		Task string `json:"task"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&task); err != nil {
+		return "", diag.FromErr(err)
+	}
+	return task.Task, nil
+}
+
+func GetReindexTask(ctx context.Context, apiClient *clients.ApiClient, taskId string) (*models.ReindexTask, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := esClient.Tasks.Get(taskId, esClient.Tasks.Get.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get the reindex task '%s'", taskId)); diags.HasError() {
+		return nil, diags
+	}
+
+	var task models.ReindexTask
+	if err := json.NewDecoder(res.Body).Decode(&task); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &task, nil
+}
+
 func PutDataStream(ctx context.Context, apiClient *clients.ApiClient, dataStreamName string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -430,11 +754,22 @@ func PutDataStream(ctx context.Context, apiClient *clients.ApiClient, dataStream
 
 func GetDataStream(ctx context.Context, apiClient *clients.ApiClient, dataStreamName string) (*models.DataStream, diag.Diagnostics) {
 	var diags diag.Diagnostics
+	dStreams, diags := GetDataStreams(ctx, apiClient, dataStreamName)
+	if len(dStreams) == 0 || diags.HasError() {
+		return nil, diags
+	}
+	// if the DataStream found in must be the first index in the data_stream object
+	ds := dStreams[0]
+	return &ds, diags
+}
+
+// GetDataStreams returns the data streams matching the name, which may contain wildcards.
+func GetDataStreams(ctx context.Context, apiClient *clients.ApiClient, name string) ([]models.DataStream, diag.Diagnostics) {
 	esClient, err := apiClient.GetESClient()
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
-	req := esClient.Indices.GetDataStream.WithName(dataStreamName)
+	req := esClient.Indices.GetDataStream.WithName(name)
 	res, err := esClient.Indices.GetDataStream(req, esClient.Indices.GetDataStream.WithContext(ctx))
 	if err != nil {
 		return nil, diag.FromErr(err)
@@ -443,7 +778,7 @@ func GetDataStream(ctx context.Context, apiClient *clients.ApiClient, dataStream
 	if res.StatusCode == http.StatusNotFound {
 		return nil, nil
 	}
-	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get requested DataStream: %s", dataStreamName)); diags.HasError() {
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get requested DataStream: %s", name)); diags.HasError() {
 		return nil, diags
 	}
 
@@ -451,9 +786,7 @@ func GetDataStream(ctx context.Context, apiClient *clients.ApiClient, dataStream
 	if err := json.NewDecoder(res.Body).Decode(&dStreams); err != nil {
 		return nil, diag.FromErr(err)
 	}
-	// if the DataStream found in must be the first index in the data_stream object
-	ds := dStreams["data_streams"][0]
-	return &ds, diags
+	return dStreams["data_streams"], nil
 }
 
 func DeleteDataStream(ctx context.Context, apiClient *clients.ApiClient, dataStreamName string) diag.Diagnostics {
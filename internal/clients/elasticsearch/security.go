@@ -6,7 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
@@ -71,6 +74,31 @@ func GetUser(ctx context.Context, apiClient *clients.ApiClient, username string)
 	return nil, diags
 }
 
+func GetUsers(ctx context.Context, apiClient *clients.ApiClient) (map[string]models.User, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := esClient.Security.GetUser(esClient.Security.GetUser.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get the users."); diags.HasError() {
+		return nil, diags
+	}
+
+	users := make(map[string]models.User)
+	if err := json.NewDecoder(res.Body).Decode(&users); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for username, user := range users {
+		user.Username = username
+		users[username] = user
+	}
+	return users, nil
+}
+
 func DeleteUser(ctx context.Context, apiClient *clients.ApiClient, username string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	esClient, err := apiClient.GetESClient()
@@ -205,6 +233,31 @@ func GetRole(ctx context.Context, apiClient *clients.ApiClient, rolename string)
 	return nil, diags
 }
 
+func GetRoles(ctx context.Context, apiClient *clients.ApiClient) (map[string]models.Role, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := esClient.Security.GetRole(esClient.Security.GetRole.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get the roles."); diags.HasError() {
+		return nil, diags
+	}
+
+	roles := make(map[string]models.Role)
+	if err := json.NewDecoder(res.Body).Decode(&roles); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for name, role := range roles {
+		role.Name = name
+		roles[name] = role
+	}
+	return roles, nil
+}
+
 func DeleteRole(ctx context.Context, apiClient *clients.ApiClient, rolename string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	esClient, err := apiClient.GetESClient()
@@ -321,6 +374,77 @@ func PutApiKey(apiClient *clients.ApiClient, apikey *models.ApiKey) (*models.Api
 	return &apiKey, diags
 }
 
+func UpdateApiKey(ctx context.Context, apiClient *clients.ApiClient, id string, apikey *models.ApiKeyUpdate) diag.Diagnostics {
+	apikeyBytes, err := json.Marshal(apikey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := performRequest(ctx, esClient, http.MethodPut, fmt.Sprintf("/_security/api_key/%s", url.PathEscape(id)), apikeyBytes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to update apikey"); diags.HasError() {
+		return diags
+	}
+
+	return nil
+}
+
+func CreateCrossClusterApiKey(ctx context.Context, apiClient *clients.ApiClient, apikey *models.CrossClusterApiKey) (*models.ApiKeyResponse, diag.Diagnostics) {
+	apikeyBytes, err := json.Marshal(apikey)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := performRequest(ctx, esClient, http.MethodPost, "/_security/cross_cluster/api_key", apikeyBytes)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to create cross cluster apikey"); diags.HasError() {
+		return nil, diags
+	}
+
+	var apiKey models.ApiKeyResponse
+	if err := json.NewDecoder(res.Body).Decode(&apiKey); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return &apiKey, nil
+}
+
+func UpdateCrossClusterApiKey(ctx context.Context, apiClient *clients.ApiClient, id string, apikey *models.CrossClusterApiKey) diag.Diagnostics {
+	apikeyBytes, err := json.Marshal(apikey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := performRequest(ctx, esClient, http.MethodPut, fmt.Sprintf("/_security/cross_cluster/api_key/%s", url.PathEscape(id)), apikeyBytes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to update cross cluster apikey"); diags.HasError() {
+		return diags
+	}
+
+	return nil
+}
+
 func GetApiKey(apiClient *clients.ApiClient, id string) (*models.ApiKeyResponse, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	esClient, err := apiClient.GetESClient()
@@ -393,3 +517,224 @@ func DeleteApiKey(apiClient *clients.ApiClient, id string) diag.Diagnostics {
 	}
 	return diags
 }
+
+func PutPrivilege(ctx context.Context, apiClient *clients.ApiClient, privilege *models.ApplicationPrivilege) diag.Diagnostics {
+	privileges := map[string]map[string]models.ApplicationPrivilege{
+		privilege.Application: {
+			privilege.Name: {
+				Actions:  privilege.Actions,
+				Metadata: privilege.Metadata,
+			},
+		},
+	}
+	privilegesBytes, err := json.Marshal(privileges)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := esClient.Security.PutPrivileges(bytes.NewReader(privilegesBytes), esClient.Security.PutPrivileges.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to create or update application privilege"); diags.HasError() {
+		return diags
+	}
+
+	return nil
+}
+
+func GetPrivilege(ctx context.Context, apiClient *clients.ApiClient, application, name string) (*models.ApplicationPrivilege, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := esClient.Security.GetPrivileges(
+		esClient.Security.GetPrivileges.WithApplication(application),
+		esClient.Security.GetPrivileges.WithName(name),
+		esClient.Security.GetPrivileges.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, "Unable to get application privilege"); diags.HasError() {
+		return nil, diags
+	}
+
+	privileges := make(map[string]map[string]models.ApplicationPrivilege)
+	if err := json.NewDecoder(res.Body).Decode(&privileges); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if privilege, ok := privileges[application][name]; ok {
+		return &privilege, nil
+	}
+	return nil, nil
+}
+
+func DeletePrivilege(ctx context.Context, apiClient *clients.ApiClient, application, name string) diag.Diagnostics {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := esClient.Security.DeletePrivileges(name, application, esClient.Security.DeletePrivileges.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to delete application privilege"); diags.HasError() {
+		return diags
+	}
+
+	return nil
+}
+
+func HasPrivileges(ctx context.Context, apiClient *clients.ApiClient, request *models.HasPrivilegesRequest, headers map[string]string) (*models.HasPrivilegesResponse, diag.Diagnostics) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := esClient.Security.HasPrivileges(
+		bytes.NewReader(requestBytes),
+		esClient.Security.HasPrivileges.WithHeader(headers),
+		esClient.Security.HasPrivileges.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to check privileges"); diags.HasError() {
+		return nil, diags
+	}
+
+	var response models.HasPrivilegesResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return &response, nil
+}
+
+func GetUserPrivileges(ctx context.Context, apiClient *clients.ApiClient, headers map[string]string) (*models.UserPrivileges, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := esClient.Security.GetUserPrivileges(
+		esClient.Security.GetUserPrivileges.WithHeader(headers),
+		esClient.Security.GetUserPrivileges.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get user privileges"); diags.HasError() {
+		return nil, diags
+	}
+
+	var privileges models.UserPrivileges
+	if err := json.NewDecoder(res.Body).Decode(&privileges); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return &privileges, nil
+}
+
+func CreateServiceToken(ctx context.Context, apiClient *clients.ApiClient, namespace, service, name string) (*models.ServiceToken, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := esClient.Security.CreateServiceToken(namespace, service, esClient.Security.CreateServiceToken.WithName(name), esClient.Security.CreateServiceToken.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to create service token"); diags.HasError() {
+		return nil, diags
+	}
+
+	var tokenResponse struct {
+		Token models.ServiceToken `json:"token"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&tokenResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return &tokenResponse.Token, nil
+}
+
+func GetServiceCredentials(ctx context.Context, apiClient *clients.ApiClient, namespace, service string) (*models.ServiceCredentials, diag.Diagnostics) {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := esClient.Security.GetServiceCredentials(namespace, service, esClient.Security.GetServiceCredentials.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get service credentials"); diags.HasError() {
+		return nil, diags
+	}
+
+	var credentials models.ServiceCredentials
+	if err := json.NewDecoder(res.Body).Decode(&credentials); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return &credentials, nil
+}
+
+func DeleteServiceToken(ctx context.Context, apiClient *clients.ApiClient, namespace, service, name string) diag.Diagnostics {
+	esClient, err := apiClient.GetESClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := esClient.Security.DeleteServiceToken(name, namespace, service, esClient.Security.DeleteServiceToken.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if diags := utils.CheckError(res, "Unable to delete service token"); diags.HasError() {
+		return diags
+	}
+
+	return nil
+}
+
+// performRequest sends a JSON request to an API which isn't available in the
+// version of the Go client in use.
+func performRequest(ctx context.Context, esClient *elasticsearch.Client, method, path string, body []byte) (*esapi.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := esClient.Perform(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &esapi.Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}, nil
+}
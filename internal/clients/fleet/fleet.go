@@ -47,6 +47,55 @@ func GetEnrollmentTokensByPolicy(ctx context.Context, client *Client, policyID s
 	return nil, reportUnknownError(resp.StatusCode(), resp.Body)
 }
 
+// ReadEnrollmentToken reads a specific enrollment token from the API.
+func ReadEnrollmentToken(ctx context.Context, client *Client, id string) (*fleetapi.EnrollmentApiKey, diag.Diagnostics) {
+	resp, err := client.API.GetEnrollmentApiKeyWithResponse(ctx, id)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return &resp.JSON200.Item, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, reportUnknownError(resp.StatusCode(), resp.Body)
+	}
+}
+
+// CreateEnrollmentToken creates a new enrollment token.
+func CreateEnrollmentToken(ctx context.Context, client *Client, req fleetapi.CreateEnrollmentApiKeysJSONRequestBody) (*fleetapi.EnrollmentApiKey, diag.Diagnostics) {
+	resp, err := client.API.CreateEnrollmentApiKeysWithResponse(ctx, req)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return &resp.JSON200.Item, nil
+	default:
+		return nil, reportUnknownError(resp.StatusCode(), resp.Body)
+	}
+}
+
+// DeleteEnrollmentToken revokes an existing enrollment token.
+func DeleteEnrollmentToken(ctx context.Context, client *Client, id string) diag.Diagnostics {
+	resp, err := client.API.DeleteEnrollmentApiKeyWithResponse(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return nil
+	default:
+		return reportUnknownError(resp.StatusCode(), resp.Body)
+	}
+}
+
 // ReadAgentPolicy reads a specific agent policy from the API.
 func ReadAgentPolicy(ctx context.Context, client *Client, id string) (*fleetapi.AgentPolicy, diag.Diagnostics) {
 	resp, err := client.API.AgentPolicyInfoWithResponse(ctx, id)
@@ -115,6 +164,42 @@ func DeleteAgentPolicy(ctx context.Context, client *Client, id string) diag.Diag
 	}
 }
 
+// GetUninstallTokensByPolicy gets the uninstall token metadata for the given policy ID.
+func GetUninstallTokensByPolicy(ctx context.Context, client *Client, policyID string) ([]fleetapi.UninstallTokenMetadata, diag.Diagnostics) {
+	params := fleetapi.GetUninstallTokensParams{
+		PolicyId: &policyID,
+	}
+
+	resp, err := client.API.GetUninstallTokensWithResponse(ctx, &params)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.JSON200.Items, nil
+	default:
+		return nil, reportUnknownError(resp.StatusCode(), resp.Body)
+	}
+}
+
+// ReadUninstallToken reads a specific decrypted uninstall token from the API.
+func ReadUninstallToken(ctx context.Context, client *Client, id string) (*fleetapi.UninstallToken, diag.Diagnostics) {
+	resp, err := client.API.GetUninstallTokenWithResponse(ctx, id)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return &resp.JSON200.Item, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, reportUnknownError(resp.StatusCode(), resp.Body)
+	}
+}
+
 // ReadOutput reads a specific output from the API.
 func ReadOutput(ctx context.Context, client *Client, id string) (*fleetapi.OutputCreateRequest, diag.Diagnostics) {
 	resp, err := client.API.GetOutputWithResponse(ctx, id)
@@ -375,6 +460,21 @@ func InstallPackage(ctx context.Context, client *Client, name, version string, f
 	}
 }
 
+// UploadPackage installs a package by uploading a .zip or .tar.gz archive.
+func UploadPackage(ctx context.Context, client *Client, contentType string, body io.Reader) diag.Diagnostics {
+	resp, err := client.API.InstallPackageByUploadWithBodyWithResponse(ctx, contentType, body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return nil
+	default:
+		return reportUnknownError(resp.StatusCode(), resp.Body)
+	}
+}
+
 // Uninstall uninstalls a package.
 func Uninstall(ctx context.Context, client *Client, name, version string, force bool) diag.Diagnostics {
 	params := fleetapi.DeletePackageParams{}
@@ -12,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
+// SloGroupByAll is the groupBy value Kibana uses for SLOs which aren't grouped.
+const SloGroupByAll = "*"
+
 func GetSlo(ctx context.Context, apiClient *clients.ApiClient, id, spaceID string) (*models.Slo, diag.Diagnostics) {
 	client, err := apiClient.GetSloClient()
 	if err != nil {
@@ -36,6 +39,45 @@ func GetSlo(ctx context.Context, apiClient *clients.ApiClient, id, spaceID strin
 	return sloResponseToModel(spaceID, sloRes), utils.CheckHttpError(res, "Unable to get slo with ID "+string(id))
 }
 
+// sloFindPageSize is the number of SLOs requested per page when listing SLOs.
+const sloFindPageSize = 100
+
+// FindSlos returns every SLO matching the KQL query. Grouped SLOs are returned
+// once per instance.
+func FindSlos(ctx context.Context, apiClient *clients.ApiClient, spaceID, kqlQuery string) ([]models.Slo, diag.Diagnostics) {
+	client, err := apiClient.GetSloClient()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	ctxWithAuth := apiClient.SetSloAuthContext(ctx)
+
+	var slos []models.Slo
+	for page := int32(1); ; page++ {
+		req := client.FindSlosOp(ctxWithAuth, spaceID).KbnXsrf("true").KqlQuery(kqlQuery).Page(page).PerPage(sloFindPageSize)
+		findRes, res, err := req.Execute()
+		if res == nil {
+			return nil, diag.FromErr(err)
+		}
+		diags := utils.CheckHttpError(res, "unable to find slos")
+		res.Body.Close()
+		if diags.HasError() {
+			return nil, diags
+		}
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		for i := range findRes.Results {
+			slos = append(slos, *sloResponseToModel(spaceID, &findRes.Results[i]))
+		}
+
+		if len(findRes.Results) < sloFindPageSize || (findRes.Total != nil && len(slos) >= int(*findRes.Total)) {
+			return slos, nil
+		}
+	}
+}
+
 func DeleteSlo(ctx context.Context, apiClient *clients.ApiClient, sloId string, spaceId string) diag.Diagnostics {
 	client, err := apiClient.GetSloClient()
 	if err != nil {
@@ -72,6 +114,7 @@ func UpdateSlo(ctx context.Context, apiClient *clients.ApiClient, s models.Slo)
 		BudgetingMethod: (*slo.BudgetingMethod)(&s.BudgetingMethod),
 		Objective:       &s.Objective,
 		Settings:        s.Settings,
+		GroupBy:         groupByFromModel(s.GroupBy),
 		Tags:            s.Tags,
 	}
 
@@ -109,7 +152,7 @@ func CreateSlo(ctx context.Context, apiClient *clients.ApiClient, s models.Slo)
 		BudgetingMethod: slo.BudgetingMethod(s.BudgetingMethod),
 		Objective:       s.Objective,
 		Settings:        s.Settings,
-		GroupBy:         s.GroupBy,
+		GroupBy:         groupByFromModel(s.GroupBy),
 		Tags:            s.Tags,
 	}
 
@@ -155,6 +198,9 @@ func responseIndicatorToCreateSloRequestIndicator(s slo.SloResponseIndicator) (s
 	case *slo.IndicatorPropertiesHistogram:
 		ret.IndicatorPropertiesHistogram = ind
 
+	case *slo.IndicatorPropertiesTimesliceMetric:
+		ret.IndicatorPropertiesTimesliceMetric = ind
+
 	default:
 		return ret, fmt.Errorf("unknown indicator type: %T", ind)
 	}
@@ -177,6 +223,42 @@ func sloResponseToModel(spaceID string, res *slo.SloResponse) *models.Slo {
 		TimeWindow:      res.TimeWindow,
 		Objective:       res.Objective,
 		Settings:        &res.Settings,
+		GroupBy:         groupByToModel(res.GroupBy),
 		Tags:            res.Tags,
+		InstanceID:      res.InstanceId,
+		Summary:         res.Summary,
+	}
+}
+
+// groupByFromModel sends a single field as a plain string, only Kibana 8.14
+// and later accept a list of fields.
+func groupByFromModel(groupBy []string) *slo.GroupBy {
+	switch len(groupBy) {
+	case 0:
+		return nil
+	case 1:
+		return &slo.GroupBy{String: &groupBy[0]}
+	default:
+		return &slo.GroupBy{ArrayOfString: &groupBy}
+	}
+}
+
+// groupByToModel flattens the string or list form of groupBy. Kibana reports
+// ungrouped SLOs with the "*" wildcard, which is mapped to an empty group by.
+func groupByToModel(groupBy slo.GroupBy) []string {
+	var fields []string
+	switch {
+	case groupBy.ArrayOfString != nil:
+		fields = *groupBy.ArrayOfString
+	case groupBy.String != nil:
+		fields = []string{*groupBy.String}
+	}
+
+	var ret []string
+	for _, f := range fields {
+		if f != SloGroupByAll {
+			ret = append(ret, f)
+		}
 	}
+	return ret
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/elastic/terraform-provider-elasticstack/generated/slo"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
 	"github.com/stretchr/testify/require"
 )
 
@@ -161,3 +162,68 @@ func Test_sloResponseToModel(t *testing.T) {
 		})
 	}
 }
+
+func Test_groupByToModel(t *testing.T) {
+	tests := []struct {
+		name     string
+		groupBy  slo.GroupBy
+		expected []string
+	}{
+		{
+			name:     "should return nil when not set",
+			groupBy:  slo.GroupBy{},
+			expected: nil,
+		},
+		{
+			name:     "should return nil for the ungrouped wildcard",
+			groupBy:  slo.GroupBy{String: utils.Pointer("*")},
+			expected: nil,
+		},
+		{
+			name:     "should return a single field",
+			groupBy:  slo.GroupBy{String: utils.Pointer("service.name")},
+			expected: []string{"service.name"},
+		},
+		{
+			name:     "should return a list of fields",
+			groupBy:  slo.GroupBy{ArrayOfString: &[]string{"service.name", "cloud.region"}},
+			expected: []string{"service.name", "cloud.region"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, groupByToModel(tt.groupBy))
+		})
+	}
+}
+
+func Test_groupByFromModel(t *testing.T) {
+	tests := []struct {
+		name     string
+		groupBy  []string
+		expected *slo.GroupBy
+	}{
+		{
+			name:     "should return nil when empty",
+			groupBy:  nil,
+			expected: nil,
+		},
+		{
+			name:     "should send a single field as a string",
+			groupBy:  []string{"service.name"},
+			expected: &slo.GroupBy{String: utils.Pointer("service.name")},
+		},
+		{
+			name:     "should send multiple fields as a list",
+			groupBy:  []string{"service.name", "cloud.region"},
+			expected: &slo.GroupBy{ArrayOfString: &[]string{"service.name", "cloud.region"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, groupByFromModel(tt.groupBy))
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceSearchableSnapshotMount() *schema.Resource {
+	mountSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"repository": {
+			Description: "Name of the repository containing the snapshot.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"snapshot": {
+			Description: "Name of the snapshot containing the index to mount.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"index": {
+			Description: "Name of the index in the snapshot to mount.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"renamed_index": {
+			Description: "Name of the mounted index. Defaults to the name of the index in the snapshot.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+		},
+		"storage": {
+			Description:  "Storage of the mounted index: `full_copy` copies the whole index to the local disks, `shared_cache` only caches the parts of the index which are searched, on the frozen tier.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "full_copy",
+			ValidateFunc: validation.StringInSlice([]string{"full_copy", "shared_cache"}, false),
+		},
+		"index_settings": {
+			Description:      "Settings of the mounted index, overriding the settings of the index in the snapshot, as JSON.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"ignore_index_settings": {
+			Description: "Settings of the index in the snapshot which aren't applied to the mounted index, which uses their default value.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"wait_for_completion": {
+			Description: "If `true`, wait for the primary shards of the mounted index to be recovered when mounting it.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+	}
+
+	utils.AddConnectionSchema(mountSchema)
+
+	return &schema.Resource{
+		Description: "Mounts an index of a snapshot as a searchable snapshot index, which is deleted when the resource is destroyed. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/searchable-snapshots-api-mount-snapshot.html",
+
+		CreateContext: resourceSearchableSnapshotMountCreate,
+		UpdateContext: resourceSearchableSnapshotMountRead,
+		ReadContext:   resourceSearchableSnapshotMountRead,
+		DeleteContext: resourceSearchableSnapshotMountDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: mountSchema,
+	}
+}
+
+func resourceSearchableSnapshotMountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	indexName := d.Get("index").(string)
+	mountedName := indexName
+	if v, ok := d.GetOk("renamed_index"); ok {
+		mountedName = v.(string)
+	}
+	id, diags := client.ID(ctx, mountedName)
+	if diags.HasError() {
+		return diags
+	}
+
+	mount := models.SearchableSnapshotMount{
+		Index: indexName,
+	}
+	if mountedName != indexName {
+		mount.RenamedIndex = mountedName
+	}
+	for _, setting := range d.Get("ignore_index_settings").([]interface{}) {
+		mount.IgnoreIndexSettings = append(mount.IgnoreIndexSettings, setting.(string))
+	}
+	if v, ok := d.GetOk("index_settings"); ok {
+		settings := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&settings); err != nil {
+			return diag.FromErr(err)
+		}
+		mount.IndexSettings = settings
+	}
+
+	if diags := elasticsearch.MountSearchableSnapshot(ctx, client, d.Get("repository").(string), d.Get("snapshot").(string), d.Get("storage").(string), &mount, d.Get("wait_for_completion").(bool)); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	return resourceSearchableSnapshotMountRead(ctx, d, meta)
+}
+
+func resourceSearchableSnapshotMountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	mountedName := compId.ResourceId
+
+	index, diags := elasticsearch.GetIndex(ctx, client, mountedName)
+	if index == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Mounted index "%s" not found, removing from state`, mountedName))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	// The snapshot the index is mounted from is recorded in its settings
+	snapshotSettings := map[string]string{
+		"repository": "index.store.snapshot.repository_name",
+		"snapshot":   "index.store.snapshot.snapshot_name",
+		"index":      "index.store.snapshot.index_name",
+	}
+	for key, setting := range snapshotSettings {
+		v, ok := index.Settings[setting].(string)
+		if !ok {
+			return diag.Errorf(`The index "%s" isn't a searchable snapshot index`, mountedName)
+		}
+		if err := d.Set(key, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	storage := "full_copy"
+	if index.Settings["index.store.snapshot.partial"] == "true" {
+		storage = "shared_cache"
+	}
+	if err := d.Set("storage", storage); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("renamed_index", mountedName); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func resourceSearchableSnapshotMountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	if diags := elasticsearch.DeleteIndex(ctx, client, compId.ResourceId); diags.HasError() {
+		return diags
+	}
+	return diags
+}
@@ -0,0 +1,108 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSearchableSnapshotMount(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkSearchableSnapshotMountDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSearchableSnapshotMount(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_searchable_snapshot_mount.test", "repository", name+"-repo"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_searchable_snapshot_mount.test", "snapshot", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_searchable_snapshot_mount.test", "index", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_searchable_snapshot_mount.test", "renamed_index", "mounted-"+name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_searchable_snapshot_mount.test", "storage", "full_copy"),
+				),
+			},
+			{
+				ResourceName:            "elasticstack_elasticsearch_searchable_snapshot_mount.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"index_settings", "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func testAccResourceSearchableSnapshotMount(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%[1]s-repo"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name                = "%[1]s"
+  number_of_replicas  = 0
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_snapshot" "test" {
+  repository           = elasticstack_elasticsearch_snapshot_repository.repo.name
+  name                 = "%[1]s"
+  indices              = [elasticstack_elasticsearch_index.test.name]
+  include_global_state = false
+}
+
+resource "elasticstack_elasticsearch_searchable_snapshot_mount" "test" {
+  repository    = elasticstack_elasticsearch_snapshot.test.repository
+  snapshot      = elasticstack_elasticsearch_snapshot.test.name
+  index         = elasticstack_elasticsearch_index.test.name
+  renamed_index = "mounted-%[1]s"
+
+  index_settings = jsonencode({
+    "index.number_of_replicas" = 0
+  })
+}
+	`, name)
+}
+
+func checkSearchableSnapshotMountDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_searchable_snapshot_mount" {
+			continue
+		}
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+
+		esClient, err := client.GetESClient()
+		if err != nil {
+			return err
+		}
+		res, err := esClient.Indices.Get([]string{compId.ResourceId})
+		if err != nil {
+			return err
+		}
+
+		if res.StatusCode != 404 {
+			return fmt.Errorf("Mounted index (%s) still exists", compId.ResourceId)
+		}
+	}
+	return nil
+}
@@ -134,6 +134,62 @@ func ResourceSlm() *schema.Resource {
 			Type:        schema.TypeString,
 			Required:    true,
 		},
+		"execute_on_change": {
+			Description: "If `true`, create a snapshot according to the policy when the policy is created or updated, without waiting for its schedule.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"last_success_snapshot_name": {
+			Description: "Name of the last snapshot successfully created by the policy.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_success_time": {
+			Description: "Time the last snapshot was successfully created by the policy.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_failure_snapshot_name": {
+			Description: "Name of the last snapshot the policy failed to create.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_failure_time": {
+			Description: "Time the policy last failed to create a snapshot.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_failure_details": {
+			Description: "Cause of the last failure of the policy.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"next_execution": {
+			Description: "Time the policy will next create a snapshot.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"snapshots_taken": {
+			Description: "Number of snapshots created by the policy.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"snapshots_failed": {
+			Description: "Number of snapshots the policy failed to create.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"snapshots_deleted": {
+			Description: "Number of snapshots of the policy deleted by the retention.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"snapshot_deletion_failures": {
+			Description: "Number of snapshots of the policy the retention failed to delete.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
 	}
 
 	utils.AddConnectionSchema(slmSchema)
@@ -233,6 +289,14 @@ func resourceSlmPut(ctx context.Context, d *schema.ResourceData, meta interface{
 		return diags
 	}
 	d.SetId(id.String())
+
+	if d.Get("execute_on_change").(bool) && (d.IsNewResource() || d.HasChangesExcept("execute_on_change", "elasticsearch_connection")) {
+		snapshotName, diags := elasticsearch.ExecuteSlm(ctx, client, slmId)
+		if diags.HasError() {
+			return diags
+		}
+		tflog.Info(ctx, "Executed SLM policy", map[string]interface{}{"policy": slmId, "snapshot": snapshotName})
+	}
 	return resourceSlmRead(ctx, d, meta)
 }
 
@@ -246,8 +310,8 @@ func resourceSlmRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return diags
 	}
 
-	slm, diags := elasticsearch.GetSlm(ctx, client, id.ResourceId)
-	if slm == nil && diags == nil {
+	slmInfo, diags := elasticsearch.GetSlm(ctx, client, id.ResourceId)
+	if slmInfo == nil && diags == nil {
 		tflog.Warn(ctx, fmt.Sprintf(`SLM policy "%s" not found, removing from state`, id.ResourceId))
 		d.SetId("")
 		return diags
@@ -255,6 +319,7 @@ func resourceSlmRead(ctx context.Context, d *schema.ResourceData, meta interface
 	if diags.HasError() {
 		return diags
 	}
+	slm := &slmInfo.Policy
 
 	if err := d.Set("name", id.ResourceId); err != nil {
 		return diag.FromErr(err)
@@ -325,9 +390,48 @@ func resourceSlmRead(ctx context.Context, d *schema.ResourceData, meta interface
 		}
 	}
 
+	if diags := setSlmExecutionData(d, slmInfo); diags.HasError() {
+		return diags
+	}
+
 	return diags
 }
 
+// setSlmExecutionData sets the last executions and the stats of the policy, which change with its executions.
+func setSlmExecutionData(d *schema.ResourceData, slmInfo *models.SnapshotPolicyInfo) diag.Diagnostics {
+	lastSuccess := models.SnapshotPolicyInvocation{}
+	if slmInfo.LastSuccess != nil {
+		lastSuccess = *slmInfo.LastSuccess
+	}
+	lastFailure := models.SnapshotPolicyInvocation{}
+	if slmInfo.LastFailure != nil {
+		lastFailure = *slmInfo.LastFailure
+	}
+	stats := models.SnapshotPolicyStats{}
+	if slmInfo.Stats != nil {
+		stats = *slmInfo.Stats
+	}
+
+	values := map[string]interface{}{
+		"last_success_snapshot_name": lastSuccess.SnapshotName,
+		"last_success_time":          lastSuccess.Time,
+		"last_failure_snapshot_name": lastFailure.SnapshotName,
+		"last_failure_time":          lastFailure.Time,
+		"last_failure_details":       lastFailure.Details,
+		"next_execution":             slmInfo.NextExecution,
+		"snapshots_taken":            stats.SnapshotsTaken,
+		"snapshots_failed":           stats.SnapshotsFailed,
+		"snapshots_deleted":          stats.SnapshotsDeleted,
+		"snapshot_deletion_failures": stats.SnapshotDeletionFailures,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
 func resourceSlmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClientFromSDKResource(d, meta)
 	if diags.HasError() {
@@ -0,0 +1,101 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceSlmStatus() *schema.Resource {
+	statusSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"operation_mode": {
+			Description:  "Operation mode of SLM: `RUNNING` to create and delete the snapshots of the policies according to their schedule and retention, `STOPPED` to pause it.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"RUNNING", "STOPPED"}, false),
+		},
+	}
+
+	utils.AddConnectionSchema(statusSchema)
+
+	return &schema.Resource{
+		Description: "Starts or stops snapshot lifecycle management. SLM is started again when the resource is destroyed. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/slm-api-stop.html",
+
+		CreateContext: resourceSlmStatusPut,
+		UpdateContext: resourceSlmStatusPut,
+		ReadContext:   resourceSlmStatusRead,
+		DeleteContext: resourceSlmStatusDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: statusSchema,
+	}
+}
+
+func resourceSlmStatusPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	id, diags := client.ID(ctx, "slm-status")
+	if diags.HasError() {
+		return diags
+	}
+
+	if d.Get("operation_mode").(string) == "STOPPED" {
+		diags = elasticsearch.StopSlm(ctx, client)
+	} else {
+		diags = elasticsearch.StartSlm(ctx, client)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	return resourceSlmStatusRead(ctx, d, meta)
+}
+
+func resourceSlmStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	operationMode, diags := elasticsearch.GetSlmStatus(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	// SLM is stopping until the snapshots in progress complete, it won't start new ones
+	if operationMode == "STOPPING" {
+		operationMode = "STOPPED"
+	}
+
+	if err := d.Set("operation_mode", operationMode); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceSlmStatusDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	// SLM runs by default
+	if diags := elasticsearch.StartSlm(ctx, client); diags.HasError() {
+		return diags
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSlmStatus(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSlmStatus("STOPPED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_slm_status.test", "operation_mode", "STOPPED"),
+				),
+			},
+			{
+				Config: testAccResourceSlmStatus("RUNNING"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_slm_status.test", "operation_mode", "RUNNING"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSlmStatus(operationMode string) string {
+	return `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_slm_status" "test" {
+  operation_mode = "` + operationMode + `"
+}
+`
+}
@@ -33,6 +33,8 @@ func TestAccResourceSLM(t *testing.T) {
 					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "include_global_state", "false"),
 					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "indices.0", "data-*"),
 					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "indices.1", "abc"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "next_execution"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "snapshots_failed", "0"),
 				),
 			},
 			{
@@ -125,3 +127,55 @@ func checkSlmDestroy(name string) func(s *terraform.State) error {
 		return nil
 	}
 }
+
+func TestAccResourceSLMExecuteOnChange(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkSlmDestroy(name),
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlmExecuteOnChange(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "execute_on_change", "true"),
+				),
+			},
+			{
+				// The snapshot created on creation completes asynchronously
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "snapshots_failed", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlmExecuteOnChange(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%[1]s-repo"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+resource "elasticstack_elasticsearch_snapshot_lifecycle" "test_slm" {
+  name = "%[1]s"
+
+  schedule           = "0 30 1 * * ?"
+  snapshot_name      = "<%[1]s-{now/d}>"
+  repository         = elasticstack_elasticsearch_snapshot_repository.repo.name
+  indices            = ["%[1]s-*"]
+  ignore_unavailable = true
+  execute_on_change  = true
+}
+	`, name)
+}
@@ -0,0 +1,240 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceSnapshot() *schema.Resource {
+	snapshotSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"repository": {
+			Description: "Name of the repository to store the snapshot in.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "Name of the snapshot, unique in the repository.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"indices": {
+			Description: "Data streams and indices to include in the snapshot. Supports the `*` wildcard. All the data streams and indices are included by default.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ignore_unavailable": {
+			Description: "If `false`, the snapshot fails if any data stream or index in indices is missing or closed. If `true`, the snapshot ignores missing or closed data streams and indices.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+		},
+		"include_global_state": {
+			Description: "If `true`, include the cluster state in the snapshot.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     true,
+		},
+		"feature_states": {
+			Description: "Feature states to include in the snapshot.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"metadata": {
+			Description:      "Attaches arbitrary metadata to the snapshot.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"partial": {
+			Description: "If `false`, the entire snapshot will fail if one or more indices included in the snapshot do not have all primary shards available.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+		},
+		"wait_for_completion": {
+			Description: "If `true`, wait for the snapshot to complete when creating it, and fail if the snapshot fails.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"uuid": {
+			Description: "UUID of the snapshot.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"state": {
+			Description: "State of the snapshot: `IN_PROGRESS`, `SUCCESS`, `PARTIAL` or `FAILED`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"start_time": {
+			Description: "Time the snapshot started.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"end_time": {
+			Description: "Time the snapshot completed.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"snapshot_indices": {
+			Description: "The indices included in the snapshot.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"snapshot_data_streams": {
+			Description: "The data streams included in the snapshot.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(snapshotSchema)
+
+	return &schema.Resource{
+		Description: "Creates a snapshot of data streams, indices and the cluster state, which is deleted when the resource is destroyed. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/create-snapshot-api.html",
+
+		CreateContext: resourceSnapshotCreate,
+		UpdateContext: resourceSnapshotRead,
+		ReadContext:   resourceSnapshotRead,
+		DeleteContext: resourceSnapshotDelete,
+
+		Schema: snapshotSchema,
+	}
+}
+
+func resourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	repository := d.Get("repository").(string)
+	name := d.Get("name").(string)
+	id, diags := client.ID(ctx, fmt.Sprintf("%s/%s", repository, name))
+	if diags.HasError() {
+		return diags
+	}
+
+	ignoreUnavailable := d.Get("ignore_unavailable").(bool)
+	includeGlobalState := d.Get("include_global_state").(bool)
+	partial := d.Get("partial").(bool)
+	config := models.SnapshotPolicyConfig{
+		IgnoreUnavailable:  &ignoreUnavailable,
+		IncludeGlobalState: &includeGlobalState,
+		Partial:            &partial,
+	}
+	for _, idx := range d.Get("indices").([]interface{}) {
+		config.Indices = append(config.Indices, idx.(string))
+	}
+	for _, state := range d.Get("feature_states").(*schema.Set).List() {
+		config.FeatureStates = append(config.FeatureStates, state.(string))
+	}
+	if v, ok := d.GetOk("metadata"); ok {
+		metadata := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&metadata); err != nil {
+			return diag.FromErr(err)
+		}
+		config.Metadata = metadata
+	}
+
+	waitForCompletion := d.Get("wait_for_completion").(bool)
+	snapshot, diags := elasticsearch.CreateSnapshot(ctx, client, repository, name, &config, waitForCompletion)
+	if diags.HasError() {
+		return diags
+	}
+	// The snapshot exists even when it failed, it's tracked to be deleted with the resource
+	d.SetId(id.String())
+
+	if snapshot != nil && snapshot.State == "FAILED" {
+		return append(diag.Errorf(`The snapshot "%s" failed: %s`, name, snapshot.Reason), resourceSnapshotRead(ctx, d, meta)...)
+	}
+	return resourceSnapshotRead(ctx, d, meta)
+}
+
+func resourceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	repository := d.Get("repository").(string)
+	name := d.Get("name").(string)
+
+	snapshot, diags := elasticsearch.GetSnapshot(ctx, client, repository, name)
+	if snapshot == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Snapshot "%s/%s" not found, removing from state`, repository, name))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("uuid", snapshot.UUID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("state", snapshot.State); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("start_time", snapshot.StartTime); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("end_time", snapshot.EndTime); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("snapshot_indices", snapshot.Indices); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("snapshot_data_streams", snapshot.DataStreams); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func resourceSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	// Deleting a snapshot in progress aborts it
+	if diags := elasticsearch.DeleteSnapshot(ctx, client, d.Get("repository").(string), d.Get("name").(string)); diags.HasError() {
+		return diags
+	}
+	return diags
+}
@@ -0,0 +1,375 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceSnapshotRepositoryVerify() *schema.Resource {
+	verifySchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the snapshot repository to verify.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"fail_on_error": {
+			Description: "If `true`, the data source fails when the repository can't be verified or analyzed. Otherwise the failures are reported in `verify_error` and `analysis.0.error`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"analyze": {
+			Description: "Also analyze the repository, by writing, reading and deleting test blobs from the nodes of the cluster. The analysis runs only if the repository is verified.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"blob_count": {
+						Description:  "Number of blobs to write.",
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      100,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"concurrency": {
+						Description:  "Number of write operations to run concurrently.",
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"read_node_count": {
+						Description:  "Number of nodes reading each blob after it's written.",
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"early_read_node_count": {
+						Description:  "Number of nodes reading each blob while it's written.",
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      2,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					"max_blob_size": {
+						Description: "Maximum size of a blob, such as `10mb`.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "10mb",
+					},
+					"max_total_data_size": {
+						Description: "Maximum total size of the blobs, such as `1gb`.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "1gb",
+					},
+					"seed": {
+						Description: "Seed of the random number generator choosing the blob sizes and the operations, to reproduce an analysis. A random seed is used by default.",
+						Type:        schema.TypeInt,
+						Optional:    true,
+					},
+					"timeout": {
+						Description:  "Maximum duration of the analysis, such as `30s`.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "30s",
+						ValidateFunc: utils.StringIsDuration,
+					},
+				},
+			},
+		},
+		"verified": {
+			Description: "Whether the repository is accessible from the nodes listed in `nodes`.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"verify_error": {
+			Description: "The reason the repository couldn't be verified, when `fail_on_error` is `false`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"nodes": {
+			Description: "Nodes which can access the repository.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "Identifier of the node.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "Name of the node.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"analysis": {
+			Description: "Result of the analysis, set only if `analyze` is configured.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"error": {
+						Description: "The reason the analysis failed, when `fail_on_error` is `false`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"coordinating_node_id": {
+						Description: "Identifier of the node which coordinated the analysis.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"coordinating_node_name": {
+						Description: "Name of the node which coordinated the analysis.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"blob_path": {
+						Description: "Path in the repository of the test blobs.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"seed": {
+						Description: "Seed of the random number generator used by the analysis.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"write_count": {
+						Description: "Number of write operations.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"write_total_size_bytes": {
+						Description: "Total size of the blobs written, in bytes.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"write_total_elapsed": {
+						Description: "Total time spent writing blobs.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"write_total_throttled": {
+						Description: "Total time spent waiting for the `max_snapshot_bytes_per_sec` throttle of the repository when writing blobs.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"write_throughput_bytes_per_sec": {
+						Description: "Average throughput of the write operations, in bytes per second.",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+					"read_count": {
+						Description: "Number of read operations.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"read_total_size_bytes": {
+						Description: "Total size of the blobs read, in bytes.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"read_total_elapsed": {
+						Description: "Total time spent reading blobs.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"read_total_wait": {
+						Description: "Total time spent waiting for the first byte of the blobs read.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"read_max_wait": {
+						Description: "Longest time spent waiting for the first byte of a blob read.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"read_total_throttled": {
+						Description: "Total time spent waiting for the `max_restore_bytes_per_sec` throttle of the repository when reading blobs.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"read_throughput_bytes_per_sec": {
+						Description: "Average throughput of the read operations, in bytes per second.",
+						Type:        schema.TypeFloat,
+						Computed:    true,
+					},
+					"listing_elapsed": {
+						Description: "Time spent listing the test blobs.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"delete_elapsed": {
+						Description: "Time spent deleting the test blobs.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(verifySchema)
+
+	return &schema.Resource{
+		Description: "Verifies a snapshot repository is accessible from the nodes of the cluster, and optionally analyzes its performance and correctness. The checks run every time the data source is read. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/verify-snapshot-repo-api.html and https://www.elastic.co/guide/en/elasticsearch/reference/current/repo-analysis-api.html",
+
+		ReadContext: dataSourceSnapshotRepositoryVerifyRead,
+
+		Schema: verifySchema,
+	}
+}
+
+func dataSourceSnapshotRepositoryVerifyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	repoName := d.Get("name").(string)
+	id, diags := client.ID(ctx, repoName)
+	if diags.HasError() {
+		return diags
+	}
+	failOnError := d.Get("fail_on_error").(bool)
+
+	verifiedNodes, diags := elasticsearch.VerifySnapshotRepository(ctx, client, repoName)
+	if diags.HasError() && failOnError {
+		return diags
+	}
+	verified := !diags.HasError()
+	if err := d.Set("verified", verified); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("verify_error", diagnosticsError(diags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	nodes := make([]interface{}, 0, len(verifiedNodes))
+	for nodeId, node := range verifiedNodes {
+		nodes = append(nodes, map[string]interface{}{
+			"id":   nodeId,
+			"name": node.Name,
+		})
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].(map[string]interface{})["name"].(string) < nodes[j].(map[string]interface{})["name"].(string)
+	})
+	if err := d.Set("nodes", nodes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	analysis := make([]interface{}, 0)
+	if v, ok := d.GetOk("analyze"); ok && verified {
+		params, diags := expandAnalyzeSnapshotRepositoryParams(v.([]interface{})[0].(map[string]interface{}))
+		if diags.HasError() {
+			return diags
+		}
+		result, diags := elasticsearch.AnalyzeSnapshotRepository(ctx, client, repoName, params)
+		if diags.HasError() {
+			if failOnError {
+				return diags
+			}
+			analysis = append(analysis, map[string]interface{}{"error": diagnosticsError(diags)})
+		} else {
+			analysis = append(analysis, flattenSnapshotRepositoryAnalysis(result))
+		}
+	}
+	if err := d.Set("analysis", analysis); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return nil
+}
+
+func expandAnalyzeSnapshotRepositoryParams(analyze map[string]interface{}) (*models.AnalyzeSnapshotRepositoryParams, diag.Diagnostics) {
+	timeout, err := time.ParseDuration(analyze["timeout"].(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	params := models.AnalyzeSnapshotRepositoryParams{
+		BlobCount:          analyze["blob_count"].(int),
+		Concurrency:        analyze["concurrency"].(int),
+		ReadNodeCount:      analyze["read_node_count"].(int),
+		EarlyReadNodeCount: analyze["early_read_node_count"].(int),
+		MaxBlobSize:        analyze["max_blob_size"].(string),
+		MaxTotalDataSize:   analyze["max_total_data_size"].(string),
+		Timeout:            timeout,
+	}
+	if seed, ok := analyze["seed"].(int); ok && seed != 0 {
+		params.Seed = &seed
+	}
+	return &params, nil
+}
+
+func flattenSnapshotRepositoryAnalysis(analysis *models.SnapshotRepositoryAnalysis) map[string]interface{} {
+	write := analysis.Summary.Write
+	read := analysis.Summary.Read
+	return map[string]interface{}{
+		"error":                          "",
+		"coordinating_node_id":           analysis.CoordinatingNode.Id,
+		"coordinating_node_name":         analysis.CoordinatingNode.Name,
+		"blob_path":                      analysis.BlobPath,
+		"seed":                           int(analysis.Seed),
+		"write_count":                    int(write.Count),
+		"write_total_size_bytes":         int(write.TotalSizeBytes),
+		"write_total_elapsed":            time.Duration(write.TotalElapsed).String(),
+		"write_total_throttled":          time.Duration(write.TotalThrottled).String(),
+		"write_throughput_bytes_per_sec": throughput(write),
+		"read_count":                     int(read.Count),
+		"read_total_size_bytes":          int(read.TotalSizeBytes),
+		"read_total_elapsed":             time.Duration(read.TotalElapsed).String(),
+		"read_total_wait":                time.Duration(read.TotalWait).String(),
+		"read_max_wait":                  time.Duration(read.MaxWait).String(),
+		"read_total_throttled":           time.Duration(read.TotalThrottled).String(),
+		"read_throughput_bytes_per_sec":  throughput(read),
+		"listing_elapsed":                time.Duration(analysis.ListingElapsed).String(),
+		"delete_elapsed":                 time.Duration(analysis.DeleteElapsed).String(),
+	}
+}
+
+func throughput(stats models.SnapshotRepositoryAnalysisStats) float64 {
+	if stats.TotalElapsed == 0 {
+		return 0
+	}
+	return float64(stats.TotalSizeBytes) / time.Duration(stats.TotalElapsed).Seconds()
+}
+
+// diagnosticsError joins the errors of the diagnostics, to report them in an attribute instead of failing.
+func diagnosticsError(diags diag.Diagnostics) string {
+	var message string
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if message != "" {
+			message += "; "
+		}
+		message += d.Summary
+		if d.Detail != "" {
+			message += fmt.Sprintf(": %s", d.Detail)
+		}
+	}
+	return message
+}
@@ -0,0 +1,130 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSnapRepoVerify(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSnapRepoVerify(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "name", name),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "verified", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "verify_error", ""),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "nodes.0.id"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "nodes.0.name"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "analysis.#", "0"),
+				),
+			},
+			{
+				Config: testAccDataSourceSnapRepoAnalyze(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "verified", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "analysis.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "analysis.0.error", ""),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "analysis.0.seed", "42"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "analysis.0.write_count", "5"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "analysis.0.coordinating_node_name"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "analysis.0.blob_path"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "analysis.0.write_throughput_bytes_per_sec"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "analysis.0.read_throughput_bytes_per_sec"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSnapRepoVerifyNoFailOnError(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSnapRepoVerifyMissing(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "verified", "false"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "verify_error"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "nodes.#", "0"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verify.test", "analysis.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSnapRepoVerify(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "test" {
+  name = "%s"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+data "elasticstack_elasticsearch_snapshot_repository_verify" "test" {
+  name = elasticstack_elasticsearch_snapshot_repository.test.name
+}
+	`, name)
+}
+
+func testAccDataSourceSnapRepoAnalyze(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "test" {
+  name = "%s"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+data "elasticstack_elasticsearch_snapshot_repository_verify" "test" {
+  name = elasticstack_elasticsearch_snapshot_repository.test.name
+
+  analyze {
+    blob_count          = 5
+    concurrency         = 2
+    read_node_count     = 1
+    max_blob_size       = "1kb"
+    max_total_data_size = "5kb"
+    seed                = 42
+  }
+}
+	`, name)
+}
+
+func testAccDataSourceSnapRepoVerifyMissing(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_snapshot_repository_verify" "test" {
+  name          = "%s"
+  fail_on_error = false
+
+  analyze {}
+}
+	`, name)
+}
@@ -0,0 +1,225 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceSnapshotRestore() *schema.Resource {
+	restoreSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"repository": {
+			Description: "Name of the repository to restore the snapshot from.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"snapshot": {
+			Description: "Name of the snapshot to restore.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"indices": {
+			Description: "Data streams and indices to restore. Supports the `*` wildcard. All the regular data streams and indices of the snapshot are restored by default.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ignore_unavailable": {
+			Description: "If `true`, the data streams and indices in `indices` which are missing from the snapshot are ignored.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+		},
+		"include_global_state": {
+			Description: "If `true`, restore the cluster state. The existing index templates, pipelines and persistent settings are overwritten.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+		},
+		"include_aliases": {
+			Description: "If `true`, restore the aliases of the restored data streams and indices.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     true,
+		},
+		"feature_states": {
+			Description: "Feature states to restore.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"partial": {
+			Description: "If `true`, the indices of the snapshot whose shards aren't all available are restored, with the missing shards recreated empty.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+		},
+		"rename_pattern": {
+			Description:  "Regular expression matching the names of the data streams and indices to rename when they are restored.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+			RequiredWith: []string{"rename_replacement"},
+		},
+		"rename_replacement": {
+			Description:  "Replacement of the names matching `rename_pattern`, which can reference its groups, such as `restored-$1`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			RequiredWith: []string{"rename_pattern"},
+		},
+		"index_settings": {
+			Description:      "Index settings overriding the settings of the restored indices, as JSON.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"ignore_index_settings": {
+			Description: "Index settings of the snapshot which aren't restored, the restored indices use their default value.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"wait_for_completion": {
+			Description: "If `true`, wait for the primary shards of the restored indices to be recovered when restoring the snapshot.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"restored_indices": {
+			Description: "The restored indices, only known when waiting for the completion of the restore.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(restoreSchema)
+
+	return &schema.Resource{
+		Description: "Restores a snapshot when it's created. Destroying the resource doesn't delete the restored data streams and indices. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/restore-snapshot-api.html",
+
+		CreateContext: resourceSnapshotRestoreCreate,
+		UpdateContext: resourceSnapshotRestoreRead,
+		ReadContext:   resourceSnapshotRestoreRead,
+		DeleteContext: resourceSnapshotRestoreDelete,
+
+		Schema: restoreSchema,
+	}
+}
+
+func resourceSnapshotRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	repository := d.Get("repository").(string)
+	snapshot := d.Get("snapshot").(string)
+	id, diags := client.ID(ctx, fmt.Sprintf("%s/%s", repository, snapshot))
+	if diags.HasError() {
+		return diags
+	}
+
+	restore, diags := expandSnapshotRestore(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	info, diags := elasticsearch.RestoreSnapshot(ctx, client, repository, snapshot, restore, d.Get("wait_for_completion").(bool))
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	restoredIndices := make([]string, 0)
+	if info != nil {
+		restoredIndices = info.Indices
+		if info.Shards.Failed > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf(`Some shards of the snapshot "%s" failed to restore`, snapshot),
+				Detail:   fmt.Sprintf("%d of the %d shards failed to restore.", info.Shards.Failed, info.Shards.Total),
+			})
+		}
+	}
+	if err := d.Set("restored_indices", restoredIndices); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func expandSnapshotRestore(d *schema.ResourceData) (*models.SnapshotRestore, diag.Diagnostics) {
+	ignoreUnavailable := d.Get("ignore_unavailable").(bool)
+	includeGlobalState := d.Get("include_global_state").(bool)
+	includeAliases := d.Get("include_aliases").(bool)
+	partial := d.Get("partial").(bool)
+	restore := models.SnapshotRestore{
+		IgnoreUnavailable:  &ignoreUnavailable,
+		IncludeGlobalState: &includeGlobalState,
+		IncludeAliases:     &includeAliases,
+		Partial:            &partial,
+		RenamePattern:      d.Get("rename_pattern").(string),
+		RenameReplacement:  d.Get("rename_replacement").(string),
+	}
+	for _, idx := range d.Get("indices").([]interface{}) {
+		restore.Indices = append(restore.Indices, idx.(string))
+	}
+	for _, state := range d.Get("feature_states").(*schema.Set).List() {
+		restore.FeatureStates = append(restore.FeatureStates, state.(string))
+	}
+	for _, setting := range d.Get("ignore_index_settings").([]interface{}) {
+		restore.IgnoreIndexSettings = append(restore.IgnoreIndexSettings, setting.(string))
+	}
+	if v, ok := d.GetOk("index_settings"); ok {
+		settings := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&settings); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		restore.IndexSettings = settings
+	}
+	return &restore, nil
+}
+
+func resourceSnapshotRestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The snapshot is restored once on creation, there is nothing to refresh
+	return nil
+}
+
+func resourceSnapshotRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,69 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSnapshotRestore(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSnapshotRestore(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_restore.test", "restored_indices.#", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_restore.test", "restored_indices.0", "restored-"+name),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSnapshotRestore(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%[1]s-repo"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name                = "%[1]s"
+  number_of_replicas  = 0
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_snapshot" "test" {
+  repository           = elasticstack_elasticsearch_snapshot_repository.repo.name
+  name                 = "%[1]s"
+  indices              = [elasticstack_elasticsearch_index.test.name]
+  include_global_state = false
+}
+
+resource "elasticstack_elasticsearch_snapshot_restore" "test" {
+  repository         = elasticstack_elasticsearch_snapshot.test.repository
+  snapshot           = elasticstack_elasticsearch_snapshot.test.name
+  indices            = [elasticstack_elasticsearch_index.test.name]
+  rename_pattern     = "(.+)"
+  rename_replacement = "restored-$1"
+
+  index_settings = jsonencode({
+    "index.number_of_replicas" = 0
+  })
+}
+	`, name)
+}
@@ -0,0 +1,95 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSnapshot(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkSnapshotDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSnapshot(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "name", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "state", "SUCCESS"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot.test", "uuid"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot.test", "end_time"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "snapshot_indices.#", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "snapshot_indices.0", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSnapshot(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%[1]s-repo"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name                = "%[1]s"
+  number_of_replicas  = 0
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_snapshot" "test" {
+  repository           = elasticstack_elasticsearch_snapshot_repository.repo.name
+  name                 = "%[1]s"
+  indices              = [elasticstack_elasticsearch_index.test.name]
+  include_global_state = false
+
+  metadata = jsonencode({
+    reason = "acceptance test"
+  })
+}
+	`, name)
+}
+
+func checkSnapshotDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_snapshot" {
+			continue
+		}
+
+		esClient, err := client.GetESClient()
+		if err != nil {
+			return err
+		}
+		res, err := esClient.Snapshot.Get(rs.Primary.Attributes["repository"], []string{rs.Primary.Attributes["name"]})
+		if err != nil {
+			return err
+		}
+
+		if res.StatusCode != 404 {
+			return fmt.Errorf("Snapshot (%s) still exists", rs.Primary.Attributes["name"])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,341 @@
+package index
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIndexAlias() *schema.Resource {
+	aliasSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the alias.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 255),
+				validation.StringNotInSlice([]string{".", ".."}, true),
+				validation.StringMatch(regexp.MustCompile(`^[^-_+]`), "cannot start with -, _, +"),
+				validation.StringMatch(regexp.MustCompile(`^[a-z0-9!$%&'()+.;=@[\]^{}~_-]+$`), "must contain lower case alphanumeric characters and selected punctuation, see: https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-add-alias.html#add-alias-api-path-params"),
+			),
+		},
+		"index": {
+			Description: "The indices the alias points to. The alias is removed from any other index.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "Name of the index, or an index pattern using `*` matching the existing indices to add the alias to.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"filter": {
+						Description:      "Query used to limit documents the alias can access through this index.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						Default:          "",
+						DiffSuppressFunc: utils.DiffJsonSuppress,
+						ValidateFunc:     validation.StringIsJSON,
+					},
+					"index_routing": {
+						Description: "Value used to route indexing operations to a specific shard. If specified, this overwrites the `routing` value for indexing operations.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "",
+					},
+					"is_write_index": {
+						Description: "If true, the index is the write index for the alias. Only one index of the alias can be the write index.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"routing": {
+						Description: "Value used to route indexing and search operations to a specific shard.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "",
+					},
+					"search_routing": {
+						Description: "Value used to route search operations to a specific shard. If specified, this overwrites the routing value for search operations.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "",
+					},
+				},
+			},
+		},
+		"is_hidden": {
+			Description: "If true, the alias is hidden.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"indices": {
+			Description: "The names of the indices the alias points to, with the index patterns resolved.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(aliasSchema)
+
+	return &schema.Resource{
+		Description: "Manages an alias pointing to one or more Elasticsearch indices. All the changes to the alias are applied atomically. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/aliases.html",
+
+		CreateContext: resourceIndexAliasPut,
+		UpdateContext: resourceIndexAliasPut,
+		ReadContext:   resourceIndexAliasRead,
+		DeleteContext: resourceIndexAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: aliasSchema,
+	}
+}
+
+func resourceIndexAliasPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	aliasName := d.Get("name").(string)
+	id, diags := client.ID(ctx, aliasName)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, diags := elasticsearch.GetIndicesWithAlias(ctx, client, aliasName)
+	if diags.HasError() {
+		return diags
+	}
+
+	actions, diags := expandIndexAliasActions(aliasName, d.Get("is_hidden").(bool), d.Get("index").(*schema.Set).List(), current)
+	if diags.HasError() {
+		return diags
+	}
+	if diags := elasticsearch.UpdateIndexAliases(ctx, client, actions); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	return resourceIndexAliasRead(ctx, d, meta)
+}
+
+// expandIndexAliasActions returns the actions pointing the alias to the configured indices, and removing it from the
+// other indices it currently points to.
+func expandIndexAliasActions(aliasName string, isHidden bool, indices []interface{}, current map[string]models.IndexAlias) ([]models.IndexAliasAction, diag.Diagnostics) {
+	var removeActions, patternActions, addActions []models.IndexAliasAction
+	patterns := make([]string, 0, len(indices))
+	writeIndices := make([]string, 0, 1)
+	for _, i := range indices {
+		index := i.(map[string]interface{})
+		indexName := index["name"].(string)
+		patterns = append(patterns, indexName)
+
+		params := make(map[string]interface{}, len(index)+1)
+		for k, v := range index {
+			params[k] = v
+		}
+		params["name"] = aliasName
+		params["is_hidden"] = isHidden
+		alias, diags := ExpandIndexAlias(params)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if alias.IsWriteIndex {
+			writeIndices = append(writeIndices, indexName)
+		}
+		action := models.IndexAliasAction{
+			Add: &models.IndexAliasActionParams{Index: indexName, Alias: aliasName, IndexAlias: alias},
+		}
+		// The indices configured by name are added last, so that their properties override the ones of the patterns
+		// matching them
+		if strings.Contains(indexName, "*") {
+			patternActions = append(patternActions, action)
+		} else {
+			addActions = append(addActions, action)
+		}
+	}
+	if len(writeIndices) > 1 {
+		sort.Strings(writeIndices)
+		return nil, diag.Errorf(`only one index can be the write index of the alias "%s", got: %s`, aliasName, strings.Join(writeIndices, ", "))
+	}
+
+	for _, indexName := range utils.SortedKeys(current) {
+		if !matchesAnyIndexPattern(patterns, indexName) {
+			removeActions = append(removeActions, models.IndexAliasAction{
+				Remove: &models.IndexAliasActionParams{Index: indexName, Alias: aliasName},
+			})
+		}
+	}
+	return append(append(removeActions, patternActions...), addActions...), nil
+}
+
+func resourceIndexAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	aliasName := compId.ResourceId
+
+	current, diags := elasticsearch.GetIndicesWithAlias(ctx, client, aliasName)
+	if diags.HasError() {
+		return diags
+	}
+	if len(current) == 0 {
+		tflog.Warn(ctx, fmt.Sprintf(`Alias "%s" not found, removing from state`, aliasName))
+		d.SetId("")
+		return nil
+	}
+
+	indices, diags := flattenIndexAliasIndices(d.Get("index").(*schema.Set).List(), current)
+	if diags.HasError() {
+		return diags
+	}
+
+	concreteIndices := utils.SortedKeys(current)
+	if err := d.Set("name", aliasName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("index", indices); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("is_hidden", current[concreteIndices[0]].IsHidden); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("indices", concreteIndices); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// flattenIndexAliasIndices refreshes the configured indices with the indices the alias points to. The indices
+// configured by name are kept, even when a configured pattern also matches them. Index patterns are kept as configured
+// as long as they match one of the indices, since the alias properties of the matching indices may differ, for example
+// on the write index of a rollover alias. The indices which aren't configured are added.
+func flattenIndexAliasIndices(configured []interface{}, current map[string]models.IndexAlias) ([]interface{}, diag.Diagnostics) {
+	result := make([]interface{}, 0, len(current))
+	matched := make(map[string]bool, len(current))
+	for _, i := range configured {
+		indexName := i.(map[string]interface{})["name"].(string)
+		if _, ok := current[indexName]; ok && !strings.Contains(indexName, "*") {
+			index, diags := flattenIndexAliasIndex(indexName, current[indexName])
+			if diags.HasError() {
+				return nil, diags
+			}
+			result = append(result, index)
+			matched[indexName] = true
+		}
+	}
+
+	for _, i := range configured {
+		index := i.(map[string]interface{})
+		indexName := index["name"].(string)
+		if !strings.Contains(indexName, "*") {
+			continue
+		}
+		found := false
+		for concreteName := range current {
+			if matchesAnyIndexPattern([]string{indexName}, concreteName) {
+				matched[concreteName] = true
+				found = true
+			}
+		}
+		if found {
+			result = append(result, index)
+		}
+	}
+
+	for _, indexName := range utils.SortedKeys(current) {
+		if matched[indexName] {
+			continue
+		}
+		index, diags := flattenIndexAliasIndex(indexName, current[indexName])
+		if diags.HasError() {
+			return nil, diags
+		}
+		result = append(result, index)
+	}
+	return result, nil
+}
+
+func flattenIndexAliasIndex(indexName string, alias models.IndexAlias) (map[string]interface{}, diag.Diagnostics) {
+	flattened, diags := FlattenIndexAlias(indexName, alias)
+	if diags.HasError() {
+		return nil, diags
+	}
+	// is_hidden is the same on all the indices, and set on the alias itself
+	index := flattened.(map[string]interface{})
+	delete(index, "is_hidden")
+	return index, nil
+}
+
+func resourceIndexAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	aliasName := compId.ResourceId
+
+	current, diags := elasticsearch.GetIndicesWithAlias(ctx, client, aliasName)
+	if diags.HasError() {
+		return diags
+	}
+	if len(current) == 0 {
+		return nil
+	}
+
+	actions := make([]models.IndexAliasAction, 0, len(current))
+	for _, indexName := range utils.SortedKeys(current) {
+		actions = append(actions, models.IndexAliasAction{
+			Remove: &models.IndexAliasActionParams{Index: indexName, Alias: aliasName},
+		})
+	}
+	return elasticsearch.UpdateIndexAliases(ctx, client, actions)
+}
+
+// matchesAnyIndexPattern returns whether the index name matches one of the index names or patterns using `*`.
+func matchesAnyIndexPattern(patterns []string, indexName string) bool {
+	for _, pattern := range patterns {
+		quoted := strings.Split(pattern, "*")
+		for i, part := range quoted {
+			quoted[i] = regexp.QuoteMeta(part)
+		}
+		if regexp.MustCompile("^" + strings.Join(quoted, ".*") + "$").MatchString(indexName) {
+			return true
+		}
+	}
+	return false
+}
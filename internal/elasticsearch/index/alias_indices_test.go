@@ -0,0 +1,52 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FlattenIndexAliasIndices(t *testing.T) {
+	configured := []interface{}{
+		map[string]interface{}{"name": "logs-*", "is_write_index": false},
+		map[string]interface{}{"name": "logs-2", "is_write_index": true},
+	}
+	current := map[string]models.IndexAlias{
+		"logs-1":  {},
+		"logs-2":  {IsWriteIndex: true},
+		"metrics": {},
+	}
+
+	indices, diags := flattenIndexAliasIndices(configured, current)
+	require.False(t, diags.HasError())
+
+	names := make([]string, len(indices))
+	for i, index := range indices {
+		names[i] = index.(map[string]interface{})["name"].(string)
+	}
+	require.Equal(t, []string{"logs-2", "logs-*", "metrics"}, names)
+	require.Equal(t, true, indices[0].(map[string]interface{})["is_write_index"])
+	require.NotContains(t, indices[0], "is_hidden")
+}
+
+func Test_ExpandIndexAliasActionsAddsPatternsFirst(t *testing.T) {
+	index := func(name string, isWriteIndex bool) map[string]interface{} {
+		return map[string]interface{}{
+			"name":           name,
+			"filter":         "",
+			"index_routing":  "",
+			"routing":        "",
+			"search_routing": "",
+			"is_write_index": isWriteIndex,
+		}
+	}
+	indices := []interface{}{index("logs-2", true), index("logs-*", false)}
+
+	actions, diags := expandIndexAliasActions("logs", false, indices, map[string]models.IndexAlias{"metrics": {}})
+	require.False(t, diags.HasError())
+	require.Len(t, actions, 3)
+	require.Equal(t, "metrics", actions[0].Remove.Index)
+	require.Equal(t, "logs-*", actions[1].Add.Index)
+	require.Equal(t, "logs-2", actions[2].Add.Index)
+}
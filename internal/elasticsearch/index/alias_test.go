@@ -0,0 +1,225 @@
+package index_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceIndexAlias(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceIndexAliasDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIndexAliasCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index_alias.test", "name", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index_alias.test", "index.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_index_alias.test", "index.*", map[string]string{
+						"name":           name + "-1",
+						"is_write_index": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_index_alias.test", "index.*", map[string]string{
+						"name":   name + "-2",
+						"filter": `{"term":{"status":"active"}}`,
+					}),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index_alias.test", "indices.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "elasticstack_elasticsearch_index_alias.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				PreConfig: func() { createTestIndex(t, name+"-archive-1") },
+				Config:    testAccResourceIndexAliasUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index_alias.test", "index.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_index_alias.test", "index.*", map[string]string{
+						"name":           name + "-2",
+						"is_write_index": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_index_alias.test", "index.*", map[string]string{
+						"name": name + "-archive-*",
+					}),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index_alias.test", "indices.#", "2"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index_alias.test", "indices.0", name+"-2"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index_alias.test", "indices.1", name+"-archive-1"),
+				),
+			},
+			{
+				Config: testAccResourceIndexAliasOverlappingPattern(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index_alias.test", "index.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_index_alias.test", "index.*", map[string]string{
+						"name":           name + "-2",
+						"is_write_index": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_index_alias.test", "index.*", map[string]string{
+						"name": name + "-*",
+					}),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index_alias.test", "indices.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceIndexAliasCreate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test_1" {
+  name                = "%[1]s-1"
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_index" "test_2" {
+  name                = "%[1]s-2"
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_index_alias" "test" {
+  name = "%[1]s"
+
+  index {
+    name           = elasticstack_elasticsearch_index.test_1.name
+    is_write_index = true
+  }
+
+  index {
+    name   = elasticstack_elasticsearch_index.test_2.name
+    filter = jsonencode({ term = { status = "active" } })
+  }
+}
+	`, name)
+}
+
+func testAccResourceIndexAliasUpdate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test_1" {
+  name                = "%[1]s-1"
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_index" "test_2" {
+  name                = "%[1]s-2"
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_index_alias" "test" {
+  name = "%[1]s"
+
+  index {
+    name           = elasticstack_elasticsearch_index.test_2.name
+    is_write_index = true
+  }
+
+  index {
+    name = "%[1]s-archive-*"
+  }
+}
+	`, name)
+}
+
+func createTestIndex(t *testing.T, indexName string) {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	esClient, err := client.GetESClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := esClient.Indices.Create(indexName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		t.Fatalf("failed to create the index %s: %s", indexName, res.String())
+	}
+	t.Cleanup(func() {
+		res, err := esClient.Indices.Delete([]string{indexName})
+		if err == nil {
+			res.Body.Close()
+		}
+	})
+}
+
+func checkResourceIndexAliasDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_index_alias" {
+			continue
+		}
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+
+		esClient, err := client.GetESClient()
+		if err != nil {
+			return err
+		}
+		res, err := esClient.Indices.GetAlias(esClient.Indices.GetAlias.WithName(compId.ResourceId))
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Alias (%s) still exists", compId.ResourceId)
+		}
+	}
+	return nil
+}
+
+func testAccResourceIndexAliasOverlappingPattern(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test_1" {
+  name                = "%[1]s-1"
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_index" "test_2" {
+  name                = "%[1]s-2"
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_index_alias" "test" {
+  name = "%[1]s"
+
+  index {
+    name = "%[1]s-*"
+  }
+
+  index {
+    name           = elasticstack_elasticsearch_index.test_2.name
+    is_write_index = true
+  }
+}
+	`, name)
+}
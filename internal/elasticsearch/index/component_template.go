@@ -200,6 +200,11 @@ func resourceComponentTemplateRead(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
+	return setComponentTemplateData(d, tpl)
+}
+
+func setComponentTemplateData(d *schema.ResourceData, tpl *models.ComponentTemplateResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
 	// set the fields
 	if err := d.Set("name", tpl.Name); err != nil {
 		return diag.FromErr(err)
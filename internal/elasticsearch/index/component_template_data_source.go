@@ -0,0 +1,120 @@
+package index
+
+import (
+	"context"
+	"sort"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceComponentTemplate() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves an existing component template. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/getting-component-templates.html",
+
+		ReadContext: dataSourceComponentTemplateRead,
+
+		Schema: dataSourceSchemaFromResource(ResourceComponentTemplate().Schema, "Name of the component template."),
+	}
+}
+
+func dataSourceComponentTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	templateName := d.Get("name").(string)
+
+	tpl, diags := elasticsearch.GetComponentTemplate(ctx, client, templateName)
+	if diags.HasError() {
+		return diags
+	}
+	if tpl == nil {
+		return diag.Errorf(`Component template "%s" not found`, templateName)
+	}
+
+	id, diags := client.ID(ctx, templateName)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	return setComponentTemplateData(d, tpl)
+}
+
+func DataSourceComponentTemplates() *schema.Resource {
+	templatesSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name_pattern": {
+			Description: "Only return the component templates whose name matches this pattern. Supports the `*` wildcard and comma-separated lists.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "*",
+		},
+		"component_templates": {
+			Description: "The matching component templates, sorted by name.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        utils.ListElemSchema(DataSourceComponentTemplate().Schema),
+		},
+	}
+
+	utils.AddConnectionSchema(templatesSchema)
+
+	return &schema.Resource{
+		Description: "Lists the component templates matching a pattern. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/getting-component-templates.html",
+
+		ReadContext: dataSourceComponentTemplatesRead,
+
+		Schema: templatesSchema,
+	}
+}
+
+func dataSourceComponentTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	pattern := d.Get("name_pattern").(string)
+
+	templates, diags := elasticsearch.GetComponentTemplates(ctx, client, pattern)
+	if diags.HasError() {
+		return diags
+	}
+
+	templateElem := DataSourceComponentTemplates().Schema["component_templates"].Elem.(*schema.Resource)
+	result := make([]interface{}, 0)
+	if templates != nil {
+		sort.Slice(templates.ComponentTemplates, func(i, j int) bool {
+			return templates.ComponentTemplates[i].Name < templates.ComponentTemplates[j].Name
+		})
+		for i := range templates.ComponentTemplates {
+			tpl := &templates.ComponentTemplates[i]
+			flattened, diags := flattenListElem(templateElem, func(d *schema.ResourceData) diag.Diagnostics {
+				return setComponentTemplateData(d, tpl)
+			})
+			if diags.HasError() {
+				return diags
+			}
+			result = append(result, flattened)
+		}
+	}
+
+	id, diags := client.ID(ctx, pattern)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if err := d.Set("component_templates", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
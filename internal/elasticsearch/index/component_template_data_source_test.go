@@ -0,0 +1,71 @@
+package index_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceComponentTemplate(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComponentTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_component_template.test", "name", name+"-a"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_component_template.test", "template.0.mappings", `{"properties":{"code":{"type":"keyword"}}}`),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_component_templates.test", "component_templates.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_component_templates.test", "component_templates.0.name", name+"-a"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_component_templates.test", "component_templates.1.name", name+"-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComponentTemplate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_component_template" "a" {
+  name = "%[1]s-a"
+
+  template {
+    mappings = jsonencode({
+      properties = {
+        code = { type = "keyword" }
+      }
+    })
+  }
+}
+
+resource "elasticstack_elasticsearch_component_template" "b" {
+  name = "%[1]s-b"
+
+  template {
+    settings = jsonencode({
+      number_of_shards = 1
+    })
+  }
+}
+
+data "elasticstack_elasticsearch_component_template" "test" {
+  name = elasticstack_elasticsearch_component_template.a.name
+}
+
+data "elasticstack_elasticsearch_component_templates" "test" {
+  name_pattern = "%[1]s-*"
+
+  depends_on = [elasticstack_elasticsearch_component_template.a, elasticstack_elasticsearch_component_template.b]
+}
+	`, name)
+}
@@ -0,0 +1,38 @@
+package index
+
+import (
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceSchemaFromResource converts the schema of a resource into the schema of a data source reading an existing
+// object by name.
+func dataSourceSchemaFromResource(resourceSchema map[string]*schema.Schema, nameDescription string) map[string]*schema.Schema {
+	for key, attr := range resourceSchema {
+		switch key {
+		case "id", "elasticsearch_connection":
+		case "name":
+			attr.Description = nameDescription
+			attr.ForceNew = false
+			attr.ValidateFunc = nil
+		default:
+			utils.ToComputedSchema(attr)
+		}
+	}
+	return resourceSchema
+}
+
+// flattenListElem returns the attributes of an element of a list data source, set with the function setting the
+// attributes of the data source returning a single object.
+func flattenListElem(elem *schema.Resource, set func(d *schema.ResourceData) diag.Diagnostics) (map[string]interface{}, diag.Diagnostics) {
+	d := elem.Data(nil)
+	if diags := set(d); diags.HasError() {
+		return nil, diags
+	}
+	result := make(map[string]interface{}, len(elem.Schema))
+	for key := range elem.Schema {
+		result[key] = d.Get(key)
+	}
+	return result, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -159,6 +160,11 @@ func resourceDataStreamRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diags
 	}
 
+	return setDataStreamData(d, ds)
+}
+
+func setDataStreamData(d *schema.ResourceData, ds *models.DataStream) diag.Diagnostics {
+	var diags diag.Diagnostics
 	if err := d.Set("name", ds.Name); err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,118 @@
+package index
+
+import (
+	"context"
+	"sort"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDataStream() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves an existing data stream. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-get-data-stream.html",
+
+		ReadContext: dataSourceDataStreamRead,
+
+		Schema: dataSourceSchemaFromResource(ResourceDataStream().Schema, "Name of the data stream."),
+	}
+}
+
+func dataSourceDataStreamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	dataStreamName := d.Get("name").(string)
+
+	ds, diags := elasticsearch.GetDataStream(ctx, client, dataStreamName)
+	if diags.HasError() {
+		return diags
+	}
+	if ds == nil {
+		return diag.Errorf(`Data stream "%s" not found`, dataStreamName)
+	}
+
+	id, diags := client.ID(ctx, dataStreamName)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	return setDataStreamData(d, ds)
+}
+
+func DataSourceDataStreams() *schema.Resource {
+	dataStreamsSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name_pattern": {
+			Description: "Only return the data streams whose name matches this pattern. Supports the `*` wildcard and comma-separated lists.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "*",
+		},
+		"data_streams": {
+			Description: "The matching data streams, sorted by name.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        utils.ListElemSchema(DataSourceDataStream().Schema),
+		},
+	}
+
+	utils.AddConnectionSchema(dataStreamsSchema)
+
+	return &schema.Resource{
+		Description: "Lists the data streams matching a pattern. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-get-data-stream.html",
+
+		ReadContext: dataSourceDataStreamsRead,
+
+		Schema: dataStreamsSchema,
+	}
+}
+
+func dataSourceDataStreamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	pattern := d.Get("name_pattern").(string)
+
+	dataStreams, diags := elasticsearch.GetDataStreams(ctx, client, pattern)
+	if diags.HasError() {
+		return diags
+	}
+
+	dataStreamElem := DataSourceDataStreams().Schema["data_streams"].Elem.(*schema.Resource)
+	sort.Slice(dataStreams, func(i, j int) bool {
+		return dataStreams[i].Name < dataStreams[j].Name
+	})
+	result := make([]interface{}, 0, len(dataStreams))
+	for i := range dataStreams {
+		ds := &dataStreams[i]
+		flattened, diags := flattenListElem(dataStreamElem, func(d *schema.ResourceData) diag.Diagnostics {
+			return setDataStreamData(d, ds)
+		})
+		if diags.HasError() {
+			return diags
+		}
+		result = append(result, flattened)
+	}
+
+	id, diags := client.ID(ctx, pattern)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if err := d.Set("data_streams", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package index_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDataStream(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDataStream(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_stream.test", "name", name+"-a"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_stream.test", "template", name),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_stream.test", "indices.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.test", "data_streams.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.test", "data_streams.0.name", name+"-a"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.test", "data_streams.1.name", name+"-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDataStream(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index_template" "test" {
+  name           = "%[1]s"
+  index_patterns = ["%[1]s-*"]
+
+  data_stream {}
+}
+
+resource "elasticstack_elasticsearch_data_stream" "a" {
+  name       = "%[1]s-a"
+  depends_on = [elasticstack_elasticsearch_index_template.test]
+}
+
+resource "elasticstack_elasticsearch_data_stream" "b" {
+  name       = "%[1]s-b"
+  depends_on = [elasticstack_elasticsearch_index_template.test]
+}
+
+data "elasticstack_elasticsearch_data_stream" "test" {
+  name = elasticstack_elasticsearch_data_stream.a.name
+}
+
+data "elasticstack_elasticsearch_data_streams" "test" {
+  name_pattern = "%[1]s-*"
+
+  depends_on = [elasticstack_elasticsearch_data_stream.a, elasticstack_elasticsearch_data_stream.b]
+}
+	`, name)
+}
@@ -114,313 +114,317 @@ func ResourceIlm() *schema.Resource {
 	}
 }
 
-var supportedActions = map[string]*schema.Schema{
-	"allocate": {
-		Description: "Updates the index settings to change which nodes are allowed to host the index shards and change the number of replicas.",
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"number_of_replicas": {
-					Description: "Number of replicas to assign to the index. Default: `0`",
-					Type:        schema.TypeInt,
-					Optional:    true,
-					Default:     0,
-				},
-				"total_shards_per_node": {
-					Description: "The maximum number of shards for the index on a single Elasticsearch node. Defaults to `-1` (unlimited). Supported from Elasticsearch version **7.16**",
-					Type:        schema.TypeInt,
-					Optional:    true,
-					Default:     -1,
-				},
-				"include": {
-					Description:      "Assigns an index to nodes that have at least one of the specified custom attributes. Must be valid JSON document.",
-					Type:             schema.TypeString,
-					Optional:         true,
-					ValidateFunc:     validation.StringIsJSON,
-					DiffSuppressFunc: utils.DiffJsonSuppress,
-					Default:          "{}",
-				},
-				"exclude": {
-					Description:      "Assigns an index to nodes that have none of the specified custom attributes. Must be valid JSON document.",
-					Type:             schema.TypeString,
-					Optional:         true,
-					ValidateFunc:     validation.StringIsJSON,
-					DiffSuppressFunc: utils.DiffJsonSuppress,
-					Default:          "{}",
-				},
-				"require": {
-					Description:      "Assigns an index to nodes that have all of the specified custom attributes. Must be valid JSON document.",
-					Type:             schema.TypeString,
-					Optional:         true,
-					ValidateFunc:     validation.StringIsJSON,
-					DiffSuppressFunc: utils.DiffJsonSuppress,
-					Default:          "{}",
+// supportedActions returns the schemas of the actions, built on each call since the data sources make them computed.
+func supportedActions() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"allocate": {
+			Description: "Updates the index settings to change which nodes are allowed to host the index shards and change the number of replicas.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"number_of_replicas": {
+						Description: "Number of replicas to assign to the index. Default: `0`",
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Default:     0,
+					},
+					"total_shards_per_node": {
+						Description: "The maximum number of shards for the index on a single Elasticsearch node. Defaults to `-1` (unlimited). Supported from Elasticsearch version **7.16**",
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Default:     -1,
+					},
+					"include": {
+						Description:      "Assigns an index to nodes that have at least one of the specified custom attributes. Must be valid JSON document.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: utils.DiffJsonSuppress,
+						Default:          "{}",
+					},
+					"exclude": {
+						Description:      "Assigns an index to nodes that have none of the specified custom attributes. Must be valid JSON document.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: utils.DiffJsonSuppress,
+						Default:          "{}",
+					},
+					"require": {
+						Description:      "Assigns an index to nodes that have all of the specified custom attributes. Must be valid JSON document.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: utils.DiffJsonSuppress,
+						Default:          "{}",
+					},
 				},
 			},
 		},
-	},
-	"delete": {
-		Description: "Permanently removes the index.",
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"delete_searchable_snapshot": {
-					Description: "Deletes the searchable snapshot created in a previous phase.",
-					Type:        schema.TypeBool,
-					Optional:    true,
-					Default:     true,
+		"delete": {
+			Description: "Permanently removes the index.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"delete_searchable_snapshot": {
+						Description: "Deletes the searchable snapshot created in a previous phase.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
 				},
 			},
 		},
-	},
-	"forcemerge": {
-		Description: "Force merges the index into the specified maximum number of segments. This action makes the index read-only.",
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"max_num_segments": {
-					Description:  "Number of segments to merge to. To fully merge the index, set to 1.",
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntAtLeast(1),
-				},
-				"index_codec": {
-					Description: "Codec used to compress the document store.",
-					Type:        schema.TypeString,
-					Optional:    true,
+		"forcemerge": {
+			Description: "Force merges the index into the specified maximum number of segments. This action makes the index read-only.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"max_num_segments": {
+						Description:  "Number of segments to merge to. To fully merge the index, set to 1.",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"index_codec": {
+						Description: "Codec used to compress the document store.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
 				},
 			},
 		},
-	},
-	"freeze": {
-		Description: "Freeze the index to minimize its memory footprint.",
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"enabled": {
-					Description: "Controls whether ILM freezes the index.",
-					Type:        schema.TypeBool,
-					Optional:    true,
-					Default:     true,
+		"freeze": {
+			Description: "Freeze the index to minimize its memory footprint.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Description: "Controls whether ILM freezes the index.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
 				},
 			},
 		},
-	},
-	"migrate": {
-		Description: `Moves the index to the data tier that corresponds to the current phase by updating the "index.routing.allocation.include._tier_preference" index setting.`,
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"enabled": {
-					Description: "Controls whether ILM automatically migrates the index during this phase.",
-					Type:        schema.TypeBool,
-					Optional:    true,
-					Default:     true,
+		"migrate": {
+			Description: `Moves the index to the data tier that corresponds to the current phase by updating the "index.routing.allocation.include._tier_preference" index setting.`,
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Description: "Controls whether ILM automatically migrates the index during this phase.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
 				},
 			},
 		},
-	},
-	"readonly": {
-		Description: "Makes the index read-only.",
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"enabled": {
-					Description: "Controls whether ILM makes the index read-only.",
-					Type:        schema.TypeBool,
-					Optional:    true,
-					Default:     true,
+		"readonly": {
+			Description: "Makes the index read-only.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Description: "Controls whether ILM makes the index read-only.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
 				},
 			},
 		},
-	},
-	"rollover": {
-		Description: "Rolls over a target to a new index when the existing index meets one or more of the rollover conditions.",
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"max_age": {
-					Description: "Triggers rollover after the maximum elapsed time from index creation is reached.",
-					Type:        schema.TypeString,
-					Optional:    true,
-				},
-				"max_docs": {
-					Description: "Triggers rollover after the specified maximum number of documents is reached.",
-					Type:        schema.TypeInt,
-					Optional:    true,
-				},
-				"max_size": {
-					Description: "Triggers rollover when the index reaches a certain size.",
-					Type:        schema.TypeString,
-					Optional:    true,
-				},
-				"max_primary_shard_size": {
-					Description: "Triggers rollover when the largest primary shard in the index reaches a certain size.",
-					Type:        schema.TypeString,
-					Optional:    true,
-				},
-				"min_age": {
-					Description: "Prevents rollover until after the minimum elapsed time from index creation is reached. Supported from Elasticsearch version **8.4**",
-					Type:        schema.TypeString,
-					Optional:    true,
-				},
-				"min_docs": {
-					Description: "Prevents rollover until after the specified minimum number of documents is reached. Supported from Elasticsearch version **8.4**",
-					Type:        schema.TypeInt,
-					Optional:    true,
-				},
-				"min_size": {
-					Description: "Prevents rollover until the index reaches a certain size.",
-					Type:        schema.TypeString,
-					Optional:    true,
-				},
-				"min_primary_shard_size": {
-					Description: "Prevents rollover until the largest primary shard in the index reaches a certain size. Supported from Elasticsearch version **8.4**",
-					Type:        schema.TypeString,
-					Optional:    true,
-				},
-				"min_primary_shard_docs": {
-					Description: "Prevents rollover until the largest primary shard in the index reaches a certain number of documents. Supported from Elasticsearch version **8.4**",
-					Type:        schema.TypeInt,
-					Optional:    true,
+		"rollover": {
+			Description: "Rolls over a target to a new index when the existing index meets one or more of the rollover conditions.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"max_age": {
+						Description: "Triggers rollover after the maximum elapsed time from index creation is reached.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"max_docs": {
+						Description: "Triggers rollover after the specified maximum number of documents is reached.",
+						Type:        schema.TypeInt,
+						Optional:    true,
+					},
+					"max_size": {
+						Description: "Triggers rollover when the index reaches a certain size.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"max_primary_shard_size": {
+						Description: "Triggers rollover when the largest primary shard in the index reaches a certain size.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"min_age": {
+						Description: "Prevents rollover until after the minimum elapsed time from index creation is reached. Supported from Elasticsearch version **8.4**",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"min_docs": {
+						Description: "Prevents rollover until after the specified minimum number of documents is reached. Supported from Elasticsearch version **8.4**",
+						Type:        schema.TypeInt,
+						Optional:    true,
+					},
+					"min_size": {
+						Description: "Prevents rollover until the index reaches a certain size.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"min_primary_shard_size": {
+						Description: "Prevents rollover until the largest primary shard in the index reaches a certain size. Supported from Elasticsearch version **8.4**",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"min_primary_shard_docs": {
+						Description: "Prevents rollover until the largest primary shard in the index reaches a certain number of documents. Supported from Elasticsearch version **8.4**",
+						Type:        schema.TypeInt,
+						Optional:    true,
+					},
 				},
 			},
 		},
-	},
-	"searchable_snapshot": {
-		Description: "Takes a snapshot of the managed index in the configured repository and mounts it as a searchable snapshot.",
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"snapshot_repository": {
-					Description: "Repository used to store the snapshot.",
-					Type:        schema.TypeString,
-					Required:    true,
-				},
-				"force_merge_index": {
-					Description: "Force merges the managed index to one segment.",
-					Type:        schema.TypeBool,
-					Optional:    true,
-					Default:     true,
+		"searchable_snapshot": {
+			Description: "Takes a snapshot of the managed index in the configured repository and mounts it as a searchable snapshot.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"snapshot_repository": {
+						Description: "Repository used to store the snapshot.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"force_merge_index": {
+						Description: "Force merges the managed index to one segment.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
 				},
 			},
 		},
-	},
-	"set_priority": {
-		Description: "Sets the priority of the index as soon as the policy enters the hot, warm, or cold phase. Higher priority indices are recovered before indices with lower priorities following a node restart. Default priority is 1.",
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"priority": {
-					Description:  "The priority for the index. Must be 0 or greater.",
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntAtLeast(0),
+		"set_priority": {
+			Description: "Sets the priority of the index as soon as the policy enters the hot, warm, or cold phase. Higher priority indices are recovered before indices with lower priorities following a node restart. Default priority is 1.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"priority": {
+						Description:  "The priority for the index. Must be 0 or greater.",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
 				},
 			},
 		},
-	},
-	"shrink": {
-		Description: "Sets a source index to read-only and shrinks it into a new index with fewer primary shards.",
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"number_of_shards": {
-					Description: "Number of shards to shrink to.",
-					Type:        schema.TypeInt,
-					Optional:    true,
-				},
-				"max_primary_shard_size": {
-					Description: "The max primary shard size for the target index.",
-					Type:        schema.TypeString,
-					Optional:    true,
-				},
-				"allow_write_after_shrink": {
-					Description: "If true, the shrunken index is made writable by removing the write block.",
-					Type:        schema.TypeBool,
-					Optional:    true,
+		"shrink": {
+			Description: "Sets a source index to read-only and shrinks it into a new index with fewer primary shards.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"number_of_shards": {
+						Description: "Number of shards to shrink to.",
+						Type:        schema.TypeInt,
+						Optional:    true,
+					},
+					"max_primary_shard_size": {
+						Description: "The max primary shard size for the target index.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"allow_write_after_shrink": {
+						Description: "If true, the shrunken index is made writable by removing the write block.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+					},
 				},
 			},
 		},
-	},
-	"unfollow": {
-		Description: "Convert a follower index to a regular index. Performed automatically before a rollover, shrink, or searchable snapshot action.",
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"enabled": {
-					Description: "Controls whether ILM makes the follower index a regular one.",
-					Type:        schema.TypeBool,
-					Optional:    true,
-					Default:     true,
+		"unfollow": {
+			Description: "Convert a follower index to a regular index. Performed automatically before a rollover, shrink, or searchable snapshot action.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Description: "Controls whether ILM makes the follower index a regular one.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
 				},
 			},
 		},
-	},
-	"wait_for_snapshot": {
-		Description: "Waits for the specified SLM policy to be executed before removing the index. This ensures that a snapshot of the deleted index is available.",
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"policy": {
-					Description: "Name of the SLM policy that the delete action should wait for.",
-					Type:        schema.TypeString,
-					Required:    true,
+		"wait_for_snapshot": {
+			Description: "Waits for the specified SLM policy to be executed before removing the index. This ensures that a snapshot of the deleted index is available.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"policy": {
+						Description: "Name of the SLM policy that the delete action should wait for.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
 				},
 			},
 		},
-	},
-	"downsample": {
-		Description: "Roll up documents within a fixed interval to a single summary document. Reduces the index footprint by storing time series data at reduced granularity.",
-		Type:        schema.TypeList,
-		Optional:    true,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"fixed_interval": {
-					Description: "Downsampling interval",
-					Type:        schema.TypeString,
-					Required:    true,
-				},
-				"wait_timeout": {
-					Description: "Downsampling interval",
-					Type:        schema.TypeString,
-					Optional:    true,
-					Computed:    true,
+		"downsample": {
+			Description: "Roll up documents within a fixed interval to a single summary document. Reduces the index footprint by storing time series data at reduced granularity.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"fixed_interval": {
+						Description: "Downsampling interval",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"wait_timeout": {
+						Description: "Downsampling interval",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+					},
 				},
 			},
 		},
-	},
+	}
 }
 
 func getSchema(actions ...string) map[string]*schema.Schema {
 	sch := make(map[string]*schema.Schema)
+	supported := supportedActions()
 	for _, a := range actions {
-		if action, ok := supportedActions[a]; ok {
+		if action, ok := supported[a]; ok {
 			sch[a] = action
 		}
 	}
@@ -638,6 +642,11 @@ func resourceIlmRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return diags
 	}
 
+	return setIlmData(d, policyId, ilmDef)
+}
+
+func setIlmData(d *schema.ResourceData, policyId string, ilmDef *models.PolicyDefinition) diag.Diagnostics {
+	var diags diag.Diagnostics
 	if err := d.Set("modified_date", ilmDef.Modified); err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,118 @@
+package index
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIlm() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves an existing index lifecycle policy. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/ilm-get-lifecycle.html",
+
+		ReadContext: dataSourceIlmRead,
+
+		Schema: dataSourceSchemaFromResource(ResourceIlm().Schema, "Identifier for the policy."),
+	}
+}
+
+func dataSourceIlmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	policyName := d.Get("name").(string)
+
+	ilmDef, diags := elasticsearch.GetIlm(ctx, client, policyName)
+	if diags.HasError() {
+		return diags
+	}
+	if ilmDef == nil {
+		return diag.Errorf(`ILM policy "%s" not found`, policyName)
+	}
+
+	id, diags := client.ID(ctx, policyName)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	return setIlmData(d, policyName, ilmDef)
+}
+
+func DataSourceIlms() *schema.Resource {
+	ilmsSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name_pattern": {
+			Description: "Only return the policies whose name matches this pattern. Supports the `*` wildcard.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "*",
+		},
+		"policies": {
+			Description: "The matching policies, sorted by name.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        utils.ListElemSchema(DataSourceIlm().Schema),
+		},
+	}
+
+	utils.AddConnectionSchema(ilmsSchema)
+
+	return &schema.Resource{
+		Description: "Lists the index lifecycle policies matching a pattern. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/ilm-get-lifecycle.html",
+
+		ReadContext: dataSourceIlmsRead,
+
+		Schema: ilmsSchema,
+	}
+}
+
+func dataSourceIlmsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	pattern := d.Get("name_pattern").(string)
+
+	// The policies are filtered here, as the API only supports comma-separated lists of names
+	policies, diags := elasticsearch.GetIlms(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+
+	policyElem := DataSourceIlms().Schema["policies"].Elem.(*schema.Resource)
+	result := make([]interface{}, 0)
+	for _, name := range utils.SortedKeys(policies) {
+		if !matchesAnyIndexPattern([]string{pattern}, name) {
+			continue
+		}
+		ilmDef := policies[name]
+		flattened, diags := flattenListElem(policyElem, func(d *schema.ResourceData) diag.Diagnostics {
+			return setIlmData(d, name, &ilmDef)
+		})
+		if diags.HasError() {
+			return diags
+		}
+		result = append(result, flattened)
+	}
+
+	id, diags := client.ID(ctx, pattern)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if err := d.Set("policies", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package index_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceIlmKeepsResourceSchema(t *testing.T) {
+	ilm := index.ResourceIlm()
+	index.DataSourceIlm()
+	index.DataSourceIlms()
+
+	require.NoError(t, ilm.InternalValidate(nil, true))
+	hot := ilm.Schema["hot"].Elem.(*schema.Resource).Schema
+	require.True(t, hot["rollover"].Optional)
+	require.False(t, hot["rollover"].Computed)
+	require.Equal(t, 1, hot["rollover"].MaxItems)
+	maxAge := hot["rollover"].Elem.(*schema.Resource).Schema["max_age"]
+	require.True(t, maxAge.Optional)
+	require.False(t, maxAge.Computed)
+}
+
+func TestAccDataSourceIlm(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIlm(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.test", "name", name+"-a"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.test", "hot.0.rollover.0.max_age", "1d"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.test", "delete.0.min_age", "2d"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycles.test", "policies.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycles.test", "policies.0.name", name+"-a"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycles.test", "policies.1.name", name+"-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIlm(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index_lifecycle" "a" {
+  name = "%[1]s-a"
+
+  hot {
+    rollover {
+      max_age = "1d"
+    }
+  }
+
+  delete {
+    min_age = "2d"
+    delete {}
+  }
+}
+
+resource "elasticstack_elasticsearch_index_lifecycle" "b" {
+  name = "%[1]s-b"
+
+  delete {
+    min_age = "30d"
+    delete {}
+  }
+}
+
+data "elasticstack_elasticsearch_index_lifecycle" "test" {
+  name = elasticstack_elasticsearch_index_lifecycle.a.name
+}
+
+data "elasticstack_elasticsearch_index_lifecycles" "test" {
+  name_pattern = "%[1]s-*"
+
+  depends_on = [elasticstack_elasticsearch_index_lifecycle.a, elasticstack_elasticsearch_index_lifecycle.b]
+}
+	`, name)
+}
@@ -0,0 +1,222 @@
+package index
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIlmExplain() *schema.Resource {
+	explainSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"index": {
+			Description: "Name of the index to explain. Supports the `*` wildcard and comma-separated lists.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"only_managed": {
+			Description: "Only return the indices managed by ILM.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"only_errors": {
+			Description: "Only return the indices which failed to execute a lifecycle step, or are managed by a missing policy.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"indices": {
+			Description: "The lifecycle state of the matching indices, sorted by name.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"index": {
+						Description: "Name of the index.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"managed": {
+						Description: "Whether the index is managed by ILM.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"policy": {
+						Description: "Name of the policy managing the index.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"age": {
+						Description: "Time elapsed since the index creation, or rollover, used to compute the phase `min_age`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"lifecycle_date_millis": {
+						Description: "Time the index age is computed from, in milliseconds since the epoch.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"phase": {
+						Description: "The current phase of the index.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"action": {
+						Description: "The current action of the index.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"step": {
+						Description: "The current step of the index, `ERROR` if the execution of a step failed.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"failed_step": {
+						Description: "The step which failed to execute.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"failed_step_retry_count": {
+						Description: "Number of automatic retries of the failed step.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"is_auto_retryable_error": {
+						Description: "Whether the failed step is retried automatically.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"step_info": {
+						Description: "Information about the current step, such as the cause of a failure, as JSON.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"phase_definition": {
+						Description: "The definition of the current phase cached when the index entered it, as JSON. The index keeps executing this definition when the policy is updated.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"phase_policy_version": {
+						Description: "The version of the policy the current phase definition was cached from.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"phase_definition_outdated": {
+						Description: "Whether the policy has been updated since the index entered the current phase. Moving the index to its current step, with the `elasticstack_elasticsearch_index_lifecycle_step` resource, refreshes the phase definition.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(explainSchema)
+
+	return &schema.Resource{
+		Description: "Retrieves the lifecycle state of indices, such as their current phase, action and step, and the failed steps. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/ilm-explain-lifecycle.html",
+
+		ReadContext: dataSourceIlmExplainRead,
+
+		Schema: explainSchema,
+	}
+}
+
+func dataSourceIlmExplainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	index := d.Get("index").(string)
+
+	explained, diags := elasticsearch.ExplainIlm(ctx, client, index, d.Get("only_managed").(bool), d.Get("only_errors").(bool))
+	if diags.HasError() {
+		return diags
+	}
+
+	// The policy versions are only needed to detect outdated phase definitions
+	var policies map[string]models.PolicyDefinition
+	for _, explain := range explained {
+		if explain.PhaseExecution != nil {
+			policies, diags = elasticsearch.GetIlms(ctx, client)
+			if diags.HasError() {
+				return diags
+			}
+			break
+		}
+	}
+
+	indices := make([]interface{}, 0, len(explained))
+	for _, name := range utils.SortedKeys(explained) {
+		flattened, diags := flattenIlmExplainIndex(explained[name], policies)
+		if diags.HasError() {
+			return diags
+		}
+		indices = append(indices, flattened)
+	}
+
+	id, diags := client.ID(ctx, index)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if err := d.Set("indices", indices); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func flattenIlmExplainIndex(explain models.IlmExplainIndex, policies map[string]models.PolicyDefinition) (map[string]interface{}, diag.Diagnostics) {
+	result := map[string]interface{}{
+		"index":                     explain.Index,
+		"managed":                   explain.Managed,
+		"policy":                    explain.Policy,
+		"age":                       explain.Age,
+		"lifecycle_date_millis":     int(explain.LifecycleDateMillis),
+		"phase":                     explain.Phase,
+		"action":                    explain.Action,
+		"step":                      explain.Step,
+		"failed_step":               explain.FailedStep,
+		"failed_step_retry_count":   explain.FailedStepRetryCount,
+		"is_auto_retryable_error":   explain.IsAutoRetryableError,
+		"step_info":                 "",
+		"phase_definition":          "",
+		"phase_policy_version":      0,
+		"phase_definition_outdated": false,
+	}
+
+	if explain.StepInfo != nil {
+		stepInfo, err := json.Marshal(explain.StepInfo)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		result["step_info"] = string(stepInfo)
+	}
+
+	if execution := explain.PhaseExecution; execution != nil {
+		if execution.PhaseDefinition != nil {
+			phaseDefinition, err := json.Marshal(execution.PhaseDefinition)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			result["phase_definition"] = string(phaseDefinition)
+		}
+		result["phase_policy_version"] = execution.Version
+		if policy, ok := policies[execution.Policy]; ok {
+			result["phase_definition_outdated"] = execution.Version < policy.Version
+		}
+	}
+	return result, nil
+}
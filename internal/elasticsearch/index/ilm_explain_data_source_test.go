@@ -0,0 +1,99 @@
+package index_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIlmExplain(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIlmExplain(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle_explain.all", "indices.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle_explain.all", "indices.0.index", name+"-managed"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle_explain.all", "indices.0.managed", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle_explain.all", "indices.0.policy", name),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_index_lifecycle_explain.all", "indices.0.phase"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_index_lifecycle_explain.all", "indices.0.age"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle_explain.all", "indices.0.phase_definition_outdated", "false"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle_explain.all", "indices.1.index", name+"-unmanaged"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle_explain.all", "indices.1.managed", "false"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle_explain.managed", "indices.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle_explain.managed", "indices.0.index", name+"-managed"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle_explain.errors", "indices.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIlmExplain(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index_lifecycle" "test" {
+  name = "%[1]s"
+
+  hot {
+    set_priority {
+      priority = 100
+    }
+  }
+
+  delete {
+    min_age = "30d"
+    delete {}
+  }
+}
+
+resource "elasticstack_elasticsearch_index" "managed" {
+  name = "%[1]s-managed"
+
+  settings {
+    setting {
+      name  = "index.lifecycle.name"
+      value = elasticstack_elasticsearch_index_lifecycle.test.name
+    }
+  }
+
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_index" "unmanaged" {
+  name                = "%[1]s-unmanaged"
+  deletion_protection = false
+}
+
+data "elasticstack_elasticsearch_index_lifecycle_explain" "all" {
+  index = "%[1]s-*"
+
+  depends_on = [elasticstack_elasticsearch_index.managed, elasticstack_elasticsearch_index.unmanaged]
+}
+
+data "elasticstack_elasticsearch_index_lifecycle_explain" "managed" {
+  index        = "%[1]s-*"
+  only_managed = true
+
+  depends_on = [elasticstack_elasticsearch_index.managed, elasticstack_elasticsearch_index.unmanaged]
+}
+
+data "elasticstack_elasticsearch_index_lifecycle_explain" "errors" {
+  index       = "%[1]s-*"
+  only_errors = true
+
+  depends_on = [elasticstack_elasticsearch_index.managed, elasticstack_elasticsearch_index.unmanaged]
+}
+	`, name)
+}
@@ -0,0 +1,238 @@
+package index
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ilmErrorStep is the step of the indices which failed to execute a lifecycle step.
+const ilmErrorStep = "ERROR"
+
+func ResourceIlmStep() *schema.Resource {
+	stepKeySchema := func(description string, partial bool) *schema.Schema {
+		return &schema.Schema{
+			Description: description,
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"phase": {
+						Description: "Name of the phase.",
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    true,
+					},
+					"action": {
+						Description: "Name of the action.",
+						Type:        schema.TypeString,
+						Required:    !partial,
+						Optional:    partial,
+						ForceNew:    true,
+					},
+					"name": {
+						Description: "Name of the step.",
+						Type:        schema.TypeString,
+						Required:    !partial,
+						Optional:    partial,
+						ForceNew:    true,
+					},
+				},
+			},
+		}
+	}
+
+	stepSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"index": {
+			Description: "Name of the index managed by ILM. Supports the `*` wildcard and comma-separated lists.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"retry_failed_steps": {
+			Description:  "Retry the failed step of the matching indices, after the cause of the failure has been fixed.",
+			Type:         schema.TypeBool,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"retry_failed_steps", "move_to_step"},
+		},
+		"move_to_step": {
+			Description: "Move the matching indices to another lifecycle step. Without `next_step`, the indices are moved to their current step, which refreshes the phase definition cached when they entered the phase with the latest version of the policy, and retries the failed steps.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"current_step": stepKeySchema("Only move the indices which are at this step. All the matching indices are moved from their current step by default.", false),
+					"next_step":    stepKeySchema("The step to move the indices to. When `action` or `name` are omitted, the indices are moved to the first action, or step, of the phase.", true),
+				},
+			},
+			ExactlyOneOf: []string{"retry_failed_steps", "move_to_step"},
+		},
+		"triggers": {
+			Description: "Arbitrary values which apply the changes again when they change, for example the version of the policy.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"indices": {
+			Description: "The names of the indices which have been retried or moved.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(stepSchema)
+
+	return &schema.Resource{
+		Description: "Retries the failed lifecycle steps of indices, or moves them to another lifecycle step, when it's created. Destroying the resource has no effect on the indices. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/ilm-retry-policy.html and https://www.elastic.co/guide/en/elasticsearch/reference/current/ilm-move-to-step.html",
+
+		CreateContext: resourceIlmStepCreate,
+		UpdateContext: resourceIlmStepRead,
+		ReadContext:   resourceIlmStepRead,
+		DeleteContext: resourceIlmStepDelete,
+
+		Schema: stepSchema,
+	}
+}
+
+func resourceIlmStepCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	index := d.Get("index").(string)
+	id, diags := client.ID(ctx, index)
+	if diags.HasError() {
+		return diags
+	}
+
+	var indices []string
+	if d.Get("retry_failed_steps").(bool) {
+		indices, diags = retryIlmFailedSteps(ctx, client, index)
+	} else if v := d.Get("move_to_step").([]interface{}); len(v) > 0 {
+		var config map[string]interface{}
+		if v[0] != nil {
+			config = v[0].(map[string]interface{})
+		}
+		indices, diags = moveIlmToStep(ctx, client, index, config)
+	} else {
+		return diag.Errorf("either `retry_failed_steps` must be enabled, or `move_to_step` must be set")
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	if err := d.Set("indices", indices); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// retryIlmFailedSteps retries the failed step of the indices matching the index pattern, returning the retried indices.
+func retryIlmFailedSteps(ctx context.Context, client *clients.ApiClient, index string) ([]string, diag.Diagnostics) {
+	explained, diags := elasticsearch.ExplainIlm(ctx, client, index, true, true)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	indices := make([]string, 0)
+	for _, name := range utils.SortedKeys(explained) {
+		// Indices managed by a missing policy are also returned, they can't be retried
+		if explained[name].Step != ilmErrorStep {
+			continue
+		}
+		tflog.Info(ctx, "Retrying the failed lifecycle step", map[string]interface{}{"index": name, "failed_step": explained[name].FailedStep})
+		if diags := elasticsearch.RetryIlm(ctx, client, name); diags.HasError() {
+			return nil, diags
+		}
+		indices = append(indices, name)
+	}
+	return indices, nil
+}
+
+// moveIlmToStep moves the indices matching the index pattern to the configured step, returning the moved indices.
+func moveIlmToStep(ctx context.Context, client *clients.ApiClient, index string, config map[string]interface{}) ([]string, diag.Diagnostics) {
+	currentStep := expandIlmStepKey(config, "current_step")
+	nextStep := expandIlmStepKey(config, "next_step")
+
+	explained, diags := elasticsearch.ExplainIlm(ctx, client, index, true, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	indices := make([]string, 0)
+	for _, name := range utils.SortedKeys(explained) {
+		explain := explained[name]
+		from := models.IlmStepKey{Phase: explain.Phase, Action: explain.Action, Name: explain.Step}
+		if currentStep != nil && *currentStep != from {
+			continue
+		}
+
+		to := from
+		if nextStep != nil {
+			to = *nextStep
+		} else if explain.Step == ilmErrorStep {
+			to.Name = explain.FailedStep
+		}
+
+		tflog.Info(ctx, "Moving the index to another lifecycle step", map[string]interface{}{
+			"index": name,
+			"from":  fmt.Sprintf("%s/%s/%s", from.Phase, from.Action, from.Name),
+			"to":    fmt.Sprintf("%s/%s/%s", to.Phase, to.Action, to.Name),
+		})
+		if diags := elasticsearch.MoveIlmToStep(ctx, client, name, from, to); diags.HasError() {
+			return nil, diags
+		}
+		indices = append(indices, name)
+	}
+	return indices, nil
+}
+
+func expandIlmStepKey(config map[string]interface{}, key string) *models.IlmStepKey {
+	if config == nil {
+		return nil
+	}
+	v := config[key].([]interface{})
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	step := v[0].(map[string]interface{})
+	return &models.IlmStepKey{
+		Phase:  step["phase"].(string),
+		Action: step["action"].(string),
+		Name:   step["name"].(string),
+	}
+}
+
+func resourceIlmStepRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The changes are applied once on creation, there is nothing to refresh
+	return nil
+}
+
+func resourceIlmStepDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
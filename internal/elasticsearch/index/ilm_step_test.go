@@ -0,0 +1,101 @@
+package index_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceIlmStep(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIlmStepMove(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index_lifecycle_step.test", "indices.#", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index_lifecycle_step.test", "indices.0", name),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle_explain.test", "indices.0.phase", "warm"),
+				),
+			},
+			{
+				Config: testAccResourceIlmStepRetry(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index_lifecycle_step.test", "retry_failed_steps", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index_lifecycle_step.test", "indices.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceIlmStepIndex = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index_lifecycle" "test" {
+  name = "%[1]s"
+
+  hot {
+    set_priority {
+      priority = 100
+    }
+  }
+
+  warm {
+    min_age = "30d"
+    set_priority {
+      priority = 50
+    }
+  }
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name = "%[1]s"
+
+  settings {
+    setting {
+      name  = "index.lifecycle.name"
+      value = elasticstack_elasticsearch_index_lifecycle.test.name
+    }
+  }
+
+  deletion_protection = false
+}
+`
+
+func testAccResourceIlmStepMove(name string) string {
+	return fmt.Sprintf(testAccResourceIlmStepIndex+`
+resource "elasticstack_elasticsearch_index_lifecycle_step" "test" {
+  index = elasticstack_elasticsearch_index.test.name
+
+  move_to_step {
+    next_step {
+      phase = "warm"
+    }
+  }
+}
+
+data "elasticstack_elasticsearch_index_lifecycle_explain" "test" {
+  index = elasticstack_elasticsearch_index.test.name
+
+  depends_on = [elasticstack_elasticsearch_index_lifecycle_step.test]
+}
+	`, name)
+}
+
+func testAccResourceIlmStepRetry(name string) string {
+	return fmt.Sprintf(testAccResourceIlmStepIndex+`
+resource "elasticstack_elasticsearch_index_lifecycle_step" "test" {
+  index              = elasticstack_elasticsearch_index.test.name
+  retry_failed_steps = true
+}
+	`, name)
+}
@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -84,6 +83,11 @@ var (
 
 var includeTypeNameMinUnsupportedVersion = version.Must(version.NewVersion("8.0.0"))
 
+const (
+	indexMigrationStrategyRecreate = "recreate"
+	indexMigrationStrategyReindex  = "reindex"
+)
+
 func init() {
 	for k, v := range staticSettingsKeys {
 		allSettingsKeys[k] = v
@@ -125,36 +129,41 @@ func ResourceIndex() *schema.Resource {
 			ForceNew:    true,
 			Optional:    true,
 		},
+		// Static settings that can be updated on a closed index, the index is replaced when they change unless
+		// allow_close_for_static_settings is set
 		"codec": {
 			Type:         schema.TypeString,
-			Description:  "The `default` value compresses stored data with LZ4 compression, but this can be set to `best_compression` which uses DEFLATE for a higher compression ratio. This can be set only on creation.",
-			ForceNew:     true,
+			Description:  "The `default` value compresses stored data with LZ4 compression, but this can be set to `best_compression` which uses DEFLATE for a higher compression ratio. Changing it recreates the index, unless `allow_close_for_static_settings` is set.",
 			Optional:     true,
 			ValidateFunc: validation.StringInSlice([]string{"best_compression"}, false),
 		},
-		"routing_partition_size": {
-			Type:        schema.TypeInt,
-			Description: "The number of shards a custom routing value can go to. This can be set only on creation.",
-			ForceNew:    true,
-			Optional:    true,
-		},
 		"load_fixed_bitset_filters_eagerly": {
 			Type:        schema.TypeBool,
-			Description: "Indicates whether cached filters are pre-loaded for nested queries. This can be set only on creation.",
-			ForceNew:    true,
+			Description: "Indicates whether cached filters are pre-loaded for nested queries. Changing it recreates the index, unless `allow_close_for_static_settings` is set.",
 			Optional:    true,
 		},
 		"shard_check_on_startup": {
 			Type:         schema.TypeString,
-			Description:  "Whether or not shards should be checked for corruption before opening. When corruption is detected, it will prevent the shard from being opened. Accepts `false`, `true`, `checksum`.",
-			ForceNew:     true,
+			Description:  "Whether or not shards should be checked for corruption before opening. When corruption is detected, it will prevent the shard from being opened. Accepts `false`, `true`, `checksum`. Changing it recreates the index, unless `allow_close_for_static_settings` is set.",
 			Optional:     true,
 			ValidateFunc: validation.StringInSlice([]string{"false", "true", "checksum"}, false),
 		},
+		"mapping_coerce": {
+			Type:        schema.TypeBool,
+			Description: "Set index level coercion setting that is applied to all mapping types. Changing it recreates the index, unless `allow_close_for_static_settings` is set.",
+			Optional:    true,
+		},
+		// Static settings that can only be set on creation
+		"routing_partition_size": {
+			Type:        schema.TypeInt,
+			Description: "The number of shards a custom routing value can go to. This can be set only on creation.",
+			ForceNew:    true,
+			Optional:    true,
+		},
 		"sort_field": {
 			Type:        schema.TypeSet,
 			Elem:        &schema.Schema{Type: schema.TypeString},
-			Description: "The field to sort shards in this index by.",
+			Description: "The field to sort shards in this index by. This can be set only on creation.",
 			ForceNew:    true,
 			Optional:    true,
 		},
@@ -162,13 +171,7 @@ func ResourceIndex() *schema.Resource {
 		"sort_order": {
 			Type:        schema.TypeList,
 			Elem:        &schema.Schema{Type: schema.TypeString},
-			Description: "The direction to sort shards in. Accepts `asc`, `desc`.",
-			ForceNew:    true,
-			Optional:    true,
-		},
-		"mapping_coerce": {
-			Type:        schema.TypeBool,
-			Description: "Set index level coercion setting that is applied to all mapping types.",
+			Description: "The direction to sort shards in. Accepts `asc`, `desc`. This can be set only on creation.",
 			ForceNew:    true,
 			Optional:    true,
 		},
@@ -395,8 +398,9 @@ func ResourceIndex() *schema.Resource {
 			Description: "Set the number of characters of the `_source` to include in the slowlog lines, `false` or `0` will skip logging the source entirely and setting it to `true` will log the entire source regardless of size. The original `_source` is reformatted by default to make sure that it fits on a single log line.",
 			Optional:    true,
 		},
-		// To change analyzer setting, the index must be closed, updated, and then reopened but it can't be handled in terraform.
-		// We raise error when they are tried to be updated instead of setting ForceNew not to have unexpected deletion.
+		// To change analyzer setting, the index must be closed, updated, and then reopened, which is only done when
+		// allow_close_for_static_settings is set. Otherwise changes are ignored instead of setting ForceNew not to have
+		// unexpected deletion.
 		"analysis_analyzer": {
 			Type:         schema.TypeString,
 			Description:  "A JSON string describing the analyzers applied to the index.",
@@ -483,7 +487,7 @@ func ResourceIndex() *schema.Resource {
 			Description: `Mapping for fields in the index.
 If specified, this mapping can include: field names, [field data types](https://www.elastic.co/guide/en/elasticsearch/reference/current/mapping-types.html), [mapping parameters](https://www.elastic.co/guide/en/elasticsearch/reference/current/mapping-params.html).
 **NOTE:**
-- Changing datatypes in the existing _mappings_ will force index to be re-created.
+- Changing datatypes in the existing _mappings_ will force index to be re-created, unless ` + "`migration_strategy`" + ` is set to ` + "`reindex`" + `.
 - Removing field will be ignored by default same as elasticsearch. You need to recreate the index to remove field completely.
 `,
 			Type:             schema.TypeString,
@@ -492,6 +496,18 @@ If specified, this mapping can include: field names, [field data types](https://
 			ValidateFunc:     validation.StringIsJSON,
 			Default:          "{}",
 		},
+		"migration_strategy": {
+			Type: schema.TypeString,
+			Description: `How to apply mapping changes that Elasticsearch can't apply in place, such as changing the type of a field. Defaults to ` + "`recreate`" + `, which destroys the index and creates it again, losing its documents.
+With ` + "`reindex`" + `, a successor index named ` + "`<name>-v<version>`" + ` is created instead, and the documents are copied into it with the reindex API. Writes to the old index are blocked while copying, the aliases are then moved to the successor in a single atomic operation, and the old index is deleted. Searches through the aliases keep working during the migration.`,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{indexMigrationStrategyRecreate, indexMigrationStrategyReindex}, false),
+		},
+		"concrete_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the index in Elasticsearch. Differs from `name` once the index has been migrated with the `reindex` migration strategy.",
+			Computed:    true,
+		},
 		// Deprecated: individual setting field should be used instead
 		"settings": {
 			Description: `DEPRECATED: Please use dedicated setting field. Configuration options for the index. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/index-modules.html#index-modules-settings.
@@ -542,6 +558,12 @@ If specified, this mapping can include: field names, [field data types](https://
 			Optional:    true,
 			Default:     false,
 		},
+		"allow_close_for_static_settings": {
+			Type:        schema.TypeBool,
+			Description: "Whether to apply changes to the static settings that can be updated on a closed index, such as `codec` and the analysis settings, by closing the index, updating its settings and reopening it. The index can't be searched or written to while it is closed. Defaults to `false`, which recreates the index when these settings change, and ignores changes to the analysis settings. Analyzers, tokenizers and filters can be added or changed, but not removed, as Elasticsearch keeps the existing definitions.",
+			Optional:    true,
+			Default:     false,
+		},
 		"wait_for_active_shards": {
 			Type:        schema.TypeString,
 			Description: "The number of shard copies that must be active before proceeding with the operation. Set to `all` or any positive integer up to the total number of shards in the index (number_of_replicas+1). Default: `1`, the primary shard. This value is ignored when running against Serverless projects.",
@@ -576,12 +598,6 @@ If specified, this mapping can include: field names, [field data types](https://
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				// first populate what we can with Read
-				diags := resourceIndexRead(ctx, d, m)
-				if diags.HasError() {
-					return nil, fmt.Errorf("unable to import requested index")
-				}
-
 				client, diags := clients.NewApiClientFromSDKResource(d, m)
 				if diags.HasError() {
 					return nil, fmt.Errorf("Unabled to create API client %v", diags)
@@ -590,7 +606,23 @@ If specified, this mapping can include: field names, [field data types](https://
 				if diags.HasError() {
 					return nil, fmt.Errorf("failed to parse provided ID")
 				}
-				indexName := compId.ResourceId
+
+				// migrated indices keep the name they're configured with, which differs from their concrete name
+				name, indexName, diags := resolveImportedIndex(ctx, client, compId.ResourceId)
+				if diags.HasError() {
+					return nil, fmt.Errorf("unable to resolve the imported index: %v", diags)
+				}
+				compId.ResourceId = indexName
+				d.SetId(compId.String())
+				if err := d.Set("name", name); err != nil {
+					return nil, err
+				}
+
+				// first populate what we can with Read
+				diags = resourceIndexRead(ctx, d, m)
+				if diags.HasError() {
+					return nil, fmt.Errorf("unable to import requested index")
+				}
 				index, diags := elasticsearch.GetIndex(ctx, client, indexName)
 				if diags.HasError() {
 					return nil, fmt.Errorf("failed to get an ES Index")
@@ -631,33 +663,86 @@ If specified, this mapping can include: field names, [field data types](https://
 			},
 		},
 
-		CustomizeDiff: customdiff.ForceNewIfChange("mappings", func(ctx context.Context, old, new, meta interface{}) bool {
-			o := make(map[string]interface{})
-			if err := json.NewDecoder(strings.NewReader(old.(string))).Decode(&o); err != nil {
-				return true
+		CustomizeDiff: resourceIndexCustomizeDiff,
+
+		Schema: indexSchema,
+	}
+}
+
+func resourceIndexCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.Get("allow_close_for_static_settings").(bool) {
+		for _, key := range closedIndexSettingsKeys {
+			fieldKey := utils.ConvertSettingsKeyToTFFieldKey(key)
+			if !d.HasChange(fieldKey) {
+				continue
 			}
-			n := make(map[string]interface{})
-			if err := json.NewDecoder(strings.NewReader(new.(string))).Decode(&n); err != nil {
-				return true
+			if err := d.ForceNew(fieldKey); err != nil {
+				return err
 			}
-			tflog.Trace(ctx, "mappings custom diff old = %+v new = %+v", o, n)
+		}
+	}
 
-			// if old defined we must check if the type of the existing fields were changed
-			if oldProps, ok := o["properties"]; ok {
-				newProps, ok := n["properties"]
-				// if the old has props but new one not, immediately force new resource
-				if !ok {
-					return true
-				}
-				return IsMappingForceNewRequired(ctx, oldProps.(map[string]interface{}), newProps.(map[string]interface{}))
+	if d.Id() != "" && d.Get("allow_close_for_static_settings").(bool) {
+		for _, key := range analysisSettingsKeys {
+			fieldKey := "analysis_" + key
+			if !d.HasChange(fieldKey) {
+				continue
 			}
+			oldAnalysis, newAnalysis := d.GetChange(fieldKey)
+			if removed := removedAnalysisDefinitions(oldAnalysis.(string), newAnalysis.(string)); len(removed) > 0 {
+				return fmt.Errorf("`%s` definitions can't be removed from an existing index, Elasticsearch keeps them when the analysis settings are updated: %s", fieldKey, strings.Join(removed, ", "))
+			}
+		}
+	}
 
-			// if all check passed, we can update the map
-			return false
-		}),
+	if !d.HasChange("mappings") {
+		return nil
+	}
+	oldMappings, newMappings := d.GetChange("mappings")
+	if !isMappingsChangeIncompatible(ctx, oldMappings.(string), newMappings.(string)) {
+		return nil
+	}
 
-		Schema: indexSchema,
+	// Existing indices using the reindex strategy are migrated to a successor index instead of being replaced
+	if d.Id() != "" && d.Get("migration_strategy").(string) == indexMigrationStrategyReindex {
+		if err := d.SetNewComputed("concrete_name"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("id")
+	}
+
+	// To prevent backwards compatibility issues, failing to force the replacement of the index is only logged,
+	// for example when the index is being created.
+	if err := d.ForceNew("mappings"); err != nil {
+		tflog.Warn(ctx, "unable to require the replacement of the index", map[string]interface{}{"error": err.Error()})
+	}
+	return nil
+}
+
+// isMappingsChangeIncompatible returns whether the new mappings can't be applied to an index created with the old ones.
+func isMappingsChangeIncompatible(ctx context.Context, oldMappings, newMappings string) bool {
+	o := make(map[string]interface{})
+	if err := json.NewDecoder(strings.NewReader(oldMappings)).Decode(&o); err != nil {
+		return true
 	}
+	n := make(map[string]interface{})
+	if err := json.NewDecoder(strings.NewReader(newMappings)).Decode(&n); err != nil {
+		return true
+	}
+	tflog.Trace(ctx, "mappings custom diff old = %+v new = %+v", o, n)
+
+	// if old defined we must check if the type of the existing fields were changed
+	if oldProps, ok := o["properties"]; ok {
+		newProps, ok := n["properties"]
+		// if the old has props but new one not, immediately force new resource
+		if !ok {
+			return true
+		}
+		return IsMappingForceNewRequired(ctx, oldProps.(map[string]interface{}), newProps.(map[string]interface{}))
+	}
+
+	// if all check passed, we can update the map
+	return false
 }
 
 func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -670,6 +755,22 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	if diags.HasError() {
 		return diags
 	}
+
+	index, params, diags := expandIndex(ctx, client, d, indexName)
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.PutIndex(ctx, client, index, params); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	return resourceIndexRead(ctx, d, meta)
+}
+
+// expandIndex builds the index and the parameters to create it with from the configuration.
+func expandIndex(ctx context.Context, client *clients.ApiClient, d *schema.ResourceData, indexName string) (*models.Index, *models.PutIndexParams, diag.Diagnostics) {
 	var index models.Index
 	index.Name = indexName
 
@@ -677,7 +778,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		aliases := v.(*schema.Set)
 		als, diags := ExpandIndexAliases(aliases)
 		if diags.HasError() {
-			return diags
+			return nil, nil, diags
 		}
 		index.Aliases = als
 	}
@@ -686,7 +787,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		maps := make(map[string]interface{})
 		if v.(string) != "" {
 			if err := json.Unmarshal([]byte(v.(string)), &maps); err != nil {
-				return diag.FromErr(err)
+				return nil, nil, diag.FromErr(err)
 			}
 		}
 		index.Mappings = maps
@@ -703,7 +804,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		bytes := []byte(analyzerJSON.(string))
 		err := json.Unmarshal(bytes, &analyzer)
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, nil, diag.FromErr(err)
 		}
 		analysis["analyzer"] = analyzer
 	}
@@ -712,7 +813,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		bytes := []byte(tokenizerJSON.(string))
 		err := json.Unmarshal(bytes, &tokenizer)
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, nil, diag.FromErr(err)
 		}
 		analysis["tokenizer"] = tokenizer
 	}
@@ -720,7 +821,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		var filter map[string]interface{}
 		bytes := []byte(charFilterJSON.(string))
 		if err := json.Unmarshal(bytes, &filter); err != nil {
-			return diag.FromErr(err)
+			return nil, nil, diag.FromErr(err)
 		}
 		analysis["char_filter"] = filter
 	}
@@ -729,7 +830,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		bytes := []byte(filterJSON.(string))
 		err := json.Unmarshal(bytes, &filter)
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, nil, diag.FromErr(err)
 		}
 		analysis["filter"] = filter
 	}
@@ -738,7 +839,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		bytes := []byte(normalizerJSON.(string))
 		err := json.Unmarshal(bytes, &normalizer)
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, nil, diag.FromErr(err)
 		}
 		analysis["normalizer"] = normalizer
 	}
@@ -753,7 +854,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			setting := s.(map[string]interface{})
 			name := setting["name"].(string)
 			if _, ok := index.Settings[name]; ok {
-				return diag.FromErr(fmt.Errorf("setting '%s' is already defined by the other field, please remove it from `settings` to avoid unexpected settings", name))
+				return nil, nil, diag.FromErr(fmt.Errorf("setting '%s' is already defined by the other field, please remove it from `settings` to avoid unexpected settings", name))
 			}
 			index.Settings[name] = setting["value"]
 		}
@@ -761,12 +862,12 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	serverVersion, diags := client.ServerVersion(ctx)
 	if diags.HasError() {
-		return diags
+		return nil, nil, diags
 	}
 
 	serverFlavor, diags := client.ServerFlavor(ctx)
 	if diags.HasError() {
-		return diags
+		return nil, nil, diags
 	}
 
 	params := models.PutIndexParams{
@@ -775,7 +876,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if includeTypeName := d.Get("include_type_name").(bool); includeTypeName {
 		if serverVersion.GreaterThanOrEqual(includeTypeNameMinUnsupportedVersion) {
-			return diag.FromErr(fmt.Errorf("'include_type_name' field is supported only for elasticsearch v7.x"))
+			return nil, nil, diag.FromErr(fmt.Errorf("'include_type_name' field is supported only for elasticsearch v7.x"))
 		}
 		params.IncludeTypeName = includeTypeName
 	}
@@ -784,23 +885,18 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		params.WaitForActiveShards = d.Get("wait_for_active_shards").(string)
 		masterTimeout, err := time.ParseDuration(d.Get("master_timeout").(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, nil, diag.FromErr(err)
 		}
 		params.MasterTimeout = masterTimeout
 	}
 
 	timeout, err := time.ParseDuration(d.Get("timeout").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, nil, diag.FromErr(err)
 	}
 	params.Timeout = timeout
 
-	if diags := elasticsearch.PutIndex(ctx, client, &index, &params); diags.HasError() {
-		return diags
-	}
-
-	d.SetId(id.String())
-	return resourceIndexRead(ctx, d, meta)
+	return &index, &params, nil
 }
 
 // Because of limitation of ES API we must handle changes to aliases, mappings and settings separately
@@ -809,7 +905,22 @@ func resourceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	if diags.HasError() {
 		return diags
 	}
-	indexName := d.Get("name").(string)
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	indexName := compId.ResourceId
+
+	if d.HasChange("mappings") && d.Get("migration_strategy").(string) == indexMigrationStrategyReindex {
+		oldMappings, newMappings := d.GetChange("mappings")
+		if isMappingsChangeIncompatible(ctx, oldMappings.(string), newMappings.(string)) {
+			diags := migrateIndex(ctx, client, d, indexName)
+			if diags.HasError() {
+				return diags
+			}
+			return append(diags, resourceIndexRead(ctx, d, meta)...)
+		}
+	}
 
 	// aliases
 	if d.HasChange("alias") {
@@ -884,6 +995,16 @@ func resourceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	// static settings, applied before the mappings which may use the updated analyzers
+	if d.Get("allow_close_for_static_settings").(bool) {
+		if settings := expandChangedClosedIndexSettings(d); len(settings) > 0 {
+			diags = updateClosedIndexSettings(ctx, client, d, indexName, settings)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
 	// mappings
 	if d.HasChange("mappings") {
 		// at this point we know there are mappings defined and there is a change which we can apply
@@ -893,7 +1014,7 @@ func resourceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
-	return resourceIndexRead(ctx, d, meta)
+	return append(diags, resourceIndexRead(ctx, d, meta)...)
 }
 
 func flattenIndexSettings(settings []interface{}) map[string]interface{} {
@@ -919,7 +1040,15 @@ func resourceIndexRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 	indexName := compId.ResourceId
 
-	if err := d.Set("name", indexName); err != nil {
+	// The configured name is kept once the index has been migrated to a successor index
+	name := d.Get("name").(string)
+	if !isConcreteIndexName(name, indexName) {
+		name = indexName
+	}
+	if err := d.Set("name", name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("concrete_name", indexName); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -934,6 +1063,11 @@ func resourceIndexRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diags
 	}
 
+	return setIndexData(d, index)
+}
+
+func setIndexData(d *schema.ResourceData, index *models.Index) diag.Diagnostics {
+	var diags diag.Diagnostics
 	if index.Aliases != nil {
 		aliases, diags := FlattenIndexAliases(index.Aliases)
 		if diags.HasError() {
@@ -0,0 +1,148 @@
+package index
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIndex() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves an existing Elasticsearch index. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-get-index.html",
+
+		ReadContext: dataSourceIndexRead,
+
+		Schema: indexDataSourceSchema(),
+	}
+}
+
+func indexDataSourceSchema() map[string]*schema.Schema {
+	resourceSchema := ResourceIndex().Schema
+	indexSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the index. Aliases and patterns aren't resolved, use the `elasticstack_elasticsearch_indices` data source to list the indices they match.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"alias":        resourceSchema["alias"],
+		"mappings":     resourceSchema["mappings"],
+		"settings_raw": resourceSchema["settings_raw"],
+	}
+	indexSchema["alias"].Description = "Aliases of the index."
+	indexSchema["mappings"].Description = "Mapping for fields in the index, as JSON."
+	indexSchema["settings_raw"].Description = "All the settings of the index, as JSON with flattened keys."
+	for _, key := range []string{"alias", "mappings", "settings_raw"} {
+		utils.ToComputedSchema(indexSchema[key])
+	}
+
+	utils.AddConnectionSchema(indexSchema)
+	return indexSchema
+}
+
+func dataSourceIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	indexName := d.Get("name").(string)
+
+	// aliases and patterns resolve to other indices, only an index with this exact name is returned
+	indices, diags := elasticsearch.GetIndices(ctx, client, indexName)
+	if diags.HasError() {
+		return diags
+	}
+	index, ok := indices[indexName]
+	if !ok {
+		return diag.Errorf(`Index "%s" not found`, indexName)
+	}
+
+	id, diags := client.ID(ctx, indexName)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	return setIndexData(d, &index)
+}
+
+func DataSourceIndices() *schema.Resource {
+	indexElem := utils.ListElemSchema(indexDataSourceSchema())
+	indicesSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name_pattern": {
+			Description: "Only return the indices whose name matches this pattern. Supports the `*` wildcard and comma-separated lists, hidden indices are only returned when they are named explicitly.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "*",
+		},
+		"indices": {
+			Description: "The matching indices, sorted by name.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        indexElem,
+		},
+	}
+
+	utils.AddConnectionSchema(indicesSchema)
+
+	return &schema.Resource{
+		Description: "Lists the Elasticsearch indices matching a pattern. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-get-index.html",
+
+		ReadContext: dataSourceIndicesRead,
+
+		Schema: indicesSchema,
+	}
+}
+
+func dataSourceIndicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	pattern := d.Get("name_pattern").(string)
+
+	indices, diags := elasticsearch.GetIndices(ctx, client, pattern)
+	if diags.HasError() {
+		return diags
+	}
+
+	indexElem := DataSourceIndices().Schema["indices"].Elem.(*schema.Resource)
+	result := make([]interface{}, 0, len(indices))
+	for _, name := range utils.SortedKeys(indices) {
+		index := indices[name]
+		flattened, diags := flattenListElem(indexElem, func(d *schema.ResourceData) diag.Diagnostics {
+			if err := d.Set("name", name); err != nil {
+				return diag.FromErr(err)
+			}
+			return setIndexData(d, &index)
+		})
+		if diags.HasError() {
+			return diags
+		}
+		result = append(result, flattened)
+	}
+
+	id, diags := client.ID(ctx, pattern)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if err := d.Set("indices", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
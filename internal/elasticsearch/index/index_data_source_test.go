@@ -0,0 +1,114 @@
+package index_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIndex(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIndex(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index.test", "name", name+"-a"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index.test", "alias.0.name", name+"-alias"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index.test", "mappings", `{"properties":{"code":{"type":"keyword"}}}`),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_index.test", "settings_raw", regexp.MustCompile(`"index.number_of_replicas":"0"`)),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_indices.test", "indices.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_indices.test", "indices.0.name", name+"-a"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_indices.test", "indices.1.name", name+"-b"),
+				),
+			},
+			{
+				Config:      testAccDataSourceIndexAlias(name),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`Index "%s-alias" not found`, name)),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIndex(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "a" {
+  name               = "%[1]s-a"
+  number_of_replicas = 0
+
+  alias {
+    name = "%[1]s-alias"
+  }
+
+  mappings = jsonencode({
+    properties = {
+      code = { type = "keyword" }
+    }
+  })
+
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_index" "b" {
+  name                = "%[1]s-b"
+  deletion_protection = false
+}
+
+data "elasticstack_elasticsearch_index" "test" {
+  name = elasticstack_elasticsearch_index.a.name
+}
+
+data "elasticstack_elasticsearch_indices" "test" {
+  name_pattern = "%[1]s-*"
+
+  depends_on = [elasticstack_elasticsearch_index.a, elasticstack_elasticsearch_index.b]
+}
+	`, name)
+}
+
+func testAccDataSourceIndexAlias(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "a" {
+  name               = "%[1]s-a"
+  number_of_replicas = 0
+
+  alias {
+    name = "%[1]s-alias"
+  }
+
+  mappings = jsonencode({
+    properties = {
+      code = { type = "keyword" }
+    }
+  })
+
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_index" "b" {
+  name                = "%[1]s-b"
+  deletion_protection = false
+}
+
+data "elasticstack_elasticsearch_index" "test" {
+  name = "%[1]s-alias"
+
+  depends_on = [elasticstack_elasticsearch_index.a]
+}
+	`, name)
+}
@@ -0,0 +1,257 @@
+package index
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// reindexPollInterval is how often the progress of the reindex task is checked when migrating an index.
+var reindexPollInterval = 5 * time.Second
+
+// writeBlockSettingsKeys are the settings preventing the documents from being copied into the successor index,
+// they are only applied once the migration is done.
+var writeBlockSettingsKeys = []string{"blocks.write", "blocks.read_only", "blocks.read_only_allow_delete"}
+
+// successorIndexName returns the name of the index succeeding the concrete index when migrating the index.
+func successorIndexName(name, concreteName string) string {
+	version := 1
+	if suffix, ok := strings.CutPrefix(concreteName, name+"-v"); ok {
+		if v, err := strconv.Atoi(suffix); err == nil {
+			version = v
+		}
+	}
+	return fmt.Sprintf("%s-v%d", name, version+1)
+}
+
+// isConcreteIndexName returns whether the concrete index is the index with the name, or one of its successors.
+func isConcreteIndexName(name, concreteName string) bool {
+	if name == "" {
+		return false
+	}
+	if concreteName == name {
+		return true
+	}
+	suffix, ok := strings.CutPrefix(concreteName, name+"-v")
+	if !ok {
+		return false
+	}
+	_, err := strconv.Atoi(suffix)
+	return err == nil
+}
+
+// migratedIndexBaseName returns the name of the index a successor index was migrated from, and the version of the
+// successor, e.g. `logs` and 2 for `logs-v2`.
+func migratedIndexBaseName(concreteName string) (string, int, bool) {
+	i := strings.LastIndex(concreteName, "-v")
+	if i <= 0 {
+		return "", 0, false
+	}
+	version, err := strconv.Atoi(concreteName[i+2:])
+	if err != nil || version < 2 {
+		return "", 0, false
+	}
+	return concreteName[:i], version, true
+}
+
+// importedIndexName returns the configured name of the concrete index. Successor indices are mapped back to the
+// name of the index they were migrated from, as long as that index no longer exists.
+func importedIndexName(ctx context.Context, client *clients.ApiClient, concreteName string) (string, diag.Diagnostics) {
+	base, _, ok := migratedIndexBaseName(concreteName)
+	if !ok {
+		return concreteName, nil
+	}
+	original, diags := elasticsearch.GetIndices(ctx, client, base)
+	if diags.HasError() {
+		return "", diags
+	}
+	if _, exists := original[base]; exists {
+		return concreteName, nil
+	}
+	return base, nil
+}
+
+// resolveImportedIndex returns the configured name and the concrete index of an imported index. The index can be
+// imported by its concrete name, through one of its aliases, or by the configured name of a migrated index.
+func resolveImportedIndex(ctx context.Context, client *clients.ApiClient, importName string) (string, string, diag.Diagnostics) {
+	indices, diags := elasticsearch.GetIndices(ctx, client, importName)
+	if diags.HasError() {
+		return "", "", diags
+	}
+
+	if _, ok := indices[importName]; ok {
+		name, diags := importedIndexName(ctx, client, importName)
+		return name, importName, diags
+	}
+
+	if len(indices) > 1 {
+		return "", "", diag.Errorf(`"%s" refers to several indices, import one of them by its name`, importName)
+	}
+	for concreteName := range indices {
+		if isConcreteIndexName(importName, concreteName) {
+			return importName, concreteName, nil
+		}
+		name, diags := importedIndexName(ctx, client, concreteName)
+		return name, concreteName, diags
+	}
+
+	// The original index is deleted once migrated, the latest successor is imported instead
+	successors, diags := elasticsearch.GetIndices(ctx, client, importName+"-v*")
+	if diags.HasError() {
+		return "", "", diags
+	}
+	concreteName, latestVersion := importName, 0
+	for successorName := range successors {
+		if base, version, ok := migratedIndexBaseName(successorName); ok && base == importName && version > latestVersion {
+			concreteName, latestVersion = successorName, version
+		}
+	}
+	return importName, concreteName, nil
+}
+
+// migrateIndex replaces the index with a successor index created from the configuration, copying the documents and
+// moving the aliases to the successor before deleting the index.
+func migrateIndex(ctx context.Context, client *clients.ApiClient, d *schema.ResourceData, indexName string) diag.Diagnostics {
+	successorName := successorIndexName(d.Get("name").(string), indexName)
+	successorId, diags := client.ID(ctx, successorName)
+	if diags.HasError() {
+		return diags
+	}
+
+	successor, params, diags := expandIndex(ctx, client, d, successorName)
+	if diags.HasError() {
+		return diags
+	}
+	// The aliases are moved to the successor once the documents have been copied
+	newAliases := successor.Aliases
+	successor.Aliases = nil
+	writeBlocks := make(map[string]interface{})
+	for _, key := range writeBlockSettingsKeys {
+		if v, ok := successor.Settings[key]; ok {
+			writeBlocks[key] = v
+			delete(successor.Settings, key)
+		}
+	}
+
+	oldAliases, _ := d.GetChange("alias")
+	currentAliases, diags := ExpandIndexAliases(oldAliases.(*schema.Set))
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Info(ctx, "Migrating index to a successor index", map[string]interface{}{"index": indexName, "successor": successorName})
+	if diags := elasticsearch.PutIndex(ctx, client, successor, params); diags.HasError() {
+		return diags
+	}
+
+	// Writes are blocked while copying so that no document is left behind
+	if diags := elasticsearch.UpdateIndexSettings(ctx, client, indexName, map[string]interface{}{"index.blocks.write": true}); diags.HasError() {
+		return append(diags, rollbackIndexMigration(ctx, client, d, indexName, successorName)...)
+	}
+
+	if diags := reindex(ctx, client, indexName, successorName); diags.HasError() {
+		return append(diags, rollbackIndexMigration(ctx, client, d, indexName, successorName)...)
+	}
+
+	if len(writeBlocks) > 0 {
+		if diags := elasticsearch.UpdateIndexSettings(ctx, client, successorName, writeBlocks); diags.HasError() {
+			return append(diags, rollbackIndexMigration(ctx, client, d, indexName, successorName)...)
+		}
+	}
+
+	actions := make([]models.IndexAliasAction, 0, len(currentAliases)+len(newAliases))
+	for aliasName := range currentAliases {
+		actions = append(actions, models.IndexAliasAction{
+			Remove: &models.IndexAliasActionParams{Index: indexName, Alias: aliasName},
+		})
+	}
+	for aliasName, alias := range newAliases {
+		alias := alias
+		actions = append(actions, models.IndexAliasAction{
+			Add: &models.IndexAliasActionParams{Index: successorName, Alias: aliasName, IndexAlias: &alias},
+		})
+	}
+	if len(actions) > 0 {
+		if diags := elasticsearch.UpdateIndexAliases(ctx, client, actions); diags.HasError() {
+			return append(diags, rollbackIndexMigration(ctx, client, d, indexName, successorName)...)
+		}
+	}
+
+	d.SetId(successorId.String())
+
+	// The migration is complete at this point, failing to clean up the old index must not fail the apply
+	if diags := elasticsearch.DeleteIndex(ctx, client, indexName); diags.HasError() {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf(`Unable to delete the index "%s" after migrating it to "%s"`, indexName, successorName),
+			Detail:   "The documents have been copied to the successor index, the old index must be deleted manually.",
+		}}
+	}
+	tflog.Info(ctx, "Index migrated to a successor index", map[string]interface{}{"index": indexName, "successor": successorName})
+	return nil
+}
+
+// reindex copies the documents of the source index into the destination index, logging the progress of the copy.
+func reindex(ctx context.Context, client *clients.ApiClient, source, dest string) diag.Diagnostics {
+	taskId, diags := elasticsearch.StartReindex(ctx, client, source, dest)
+	if diags.HasError() {
+		return diags
+	}
+
+	for {
+		task, diags := elasticsearch.GetReindexTask(ctx, client, taskId)
+		if diags.HasError() {
+			return diags
+		}
+
+		if task.Completed {
+			if task.Error != nil {
+				return diag.Errorf(`reindexing "%s" into "%s" failed: %v`, source, dest, task.Error)
+			}
+			if task.Response != nil && len(task.Response.Failures) > 0 {
+				return diag.Errorf(`reindexing "%s" into "%s" failed for %d documents, first failure: %v`, source, dest, len(task.Response.Failures), task.Response.Failures[0])
+			}
+			tflog.Info(ctx, "Reindex completed", map[string]interface{}{"task": taskId, "source": source, "dest": dest})
+			return nil
+		}
+
+		status := task.Task.Status
+		tflog.Info(ctx, "Reindex in progress", map[string]interface{}{
+			"task":    taskId,
+			"total":   status.Total,
+			"created": status.Created,
+			"updated": status.Updated,
+		})
+
+		select {
+		case <-ctx.Done():
+			return diag.Errorf(`reindexing "%s" into "%s" didn't complete in time, task %s: %s`, source, dest, taskId, ctx.Err())
+		case <-time.After(reindexPollInterval):
+		}
+	}
+}
+
+// rollbackIndexMigration deletes the successor index and restores writes to the index being migrated.
+func rollbackIndexMigration(ctx context.Context, client *clients.ApiClient, d *schema.ResourceData, indexName, successorName string) diag.Diagnostics {
+	// The migration may have failed because the context expired, the rollback must still be attempted
+	ctx = context.WithoutCancel(ctx)
+
+	var diags diag.Diagnostics
+	diags = append(diags, elasticsearch.DeleteIndex(ctx, client, successorName)...)
+
+	var writeBlock interface{}
+	if blocked, _ := d.GetChange("blocks_write"); blocked.(bool) {
+		writeBlock = true
+	}
+	diags = append(diags, elasticsearch.UpdateIndexSettings(ctx, client, indexName, map[string]interface{}{"index.blocks.write": writeBlock})...)
+	return diags
+}
@@ -0,0 +1,43 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SuccessorIndexName(t *testing.T) {
+	require.Equal(t, "logs-v2", successorIndexName("logs", "logs"))
+	require.Equal(t, "logs-v3", successorIndexName("logs", "logs-v2"))
+	require.Equal(t, "logs-v11", successorIndexName("logs", "logs-v10"))
+	require.Equal(t, "logs-v2-v2", successorIndexName("logs-v2", "logs-v2"))
+}
+
+func Test_IsConcreteIndexName(t *testing.T) {
+	require.True(t, isConcreteIndexName("logs", "logs"))
+	require.True(t, isConcreteIndexName("logs", "logs-v2"))
+	require.False(t, isConcreteIndexName("logs", "logs-vnext"))
+	require.False(t, isConcreteIndexName("logs", "metrics"))
+	require.False(t, isConcreteIndexName("", "logs"))
+}
+
+func Test_MigratedIndexBaseName(t *testing.T) {
+	for concreteName, expected := range map[string]struct {
+		base    string
+		version int
+		ok      bool
+	}{
+		"logs-v2":    {"logs", 2, true},
+		"logs-v10":   {"logs", 10, true},
+		"logs-v2-v3": {"logs-v2", 3, true},
+		"logs":       {"", 0, false},
+		"logs-v1":    {"", 0, false},
+		"logs-vnext": {"", 0, false},
+		"-v2":        {"", 0, false},
+	} {
+		base, version, ok := migratedIndexBaseName(concreteName)
+		require.Equal(t, expected.ok, ok, concreteName)
+		require.Equal(t, expected.base, base, concreteName)
+		require.Equal(t, expected.version, version, concreteName)
+	}
+}
@@ -0,0 +1,134 @@
+package index
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// closedIndexSettingsKeys are the static settings which can be updated once the index is closed, the other static
+// settings can only be set on creation.
+var closedIndexSettingsKeys = []string{"codec", "load_fixed_bitset_filters_eagerly", "shard.check_on_startup", "mapping.coerce"}
+
+// analysisSettingsKeys are the analysis settings, configured as JSON in the `analysis_<key>` fields.
+var analysisSettingsKeys = []string{"analyzer", "tokenizer", "char_filter", "filter", "normalizer"}
+
+// expandChangedClosedIndexSettings returns the changed settings which can only be updated on a closed index.
+func expandChangedClosedIndexSettings(d *schema.ResourceData) map[string]interface{} {
+	settings := make(map[string]interface{})
+	for _, key := range closedIndexSettingsKeys {
+		fieldKey := utils.ConvertSettingsKeyToTFFieldKey(key)
+		if !d.HasChange(fieldKey) {
+			continue
+		}
+		// Settings removed from the configuration are reset to their default value, GetOk can't tell them apart
+		// from settings set to `false`
+		if isRemovedFromConfig(d, fieldKey) {
+			settings[key] = nil
+		} else {
+			settings[key] = d.Get(fieldKey)
+		}
+	}
+
+	analysisChanged := false
+	for _, key := range analysisSettingsKeys {
+		analysisChanged = analysisChanged || d.HasChange("analysis_"+key)
+	}
+	if analysisChanged {
+		analysis := make(map[string]interface{})
+		for _, key := range analysisSettingsKeys {
+			if v, ok := d.GetOk("analysis_" + key); ok {
+				var value map[string]interface{}
+				// The JSON has been validated by the schema
+				_ = json.Unmarshal([]byte(v.(string)), &value)
+				analysis[key] = value
+			}
+		}
+		if len(analysis) > 0 {
+			settings["analysis"] = analysis
+		}
+	}
+	return settings
+}
+
+// isRemovedFromConfig returns whether the top level attribute is missing from the configuration.
+func isRemovedFromConfig(d *schema.ResourceData, fieldKey string) bool {
+	if null, ok := isNullInConfig(d.GetRawConfig(), fieldKey); ok {
+		return null
+	}
+	_, ok := d.GetOk(fieldKey)
+	return !ok
+}
+
+// isNullInConfig returns whether the attribute is null in the raw configuration, and whether it could be determined.
+func isNullInConfig(config cty.Value, fieldKey string) (bool, bool) {
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(fieldKey) {
+		return false, false
+	}
+	return config.GetAttr(fieldKey).IsNull(), true
+}
+
+// removedAnalysisDefinitions returns the analyzers, tokenizers and filters removed from the analysis settings.
+// Elasticsearch merges the analysis settings of an index, so removing a definition has no effect.
+func removedAnalysisDefinitions(oldAnalysis, newAnalysis string) []string {
+	var oldDefinitions, newDefinitions map[string]interface{}
+	if err := json.Unmarshal([]byte(oldAnalysis), &oldDefinitions); err != nil {
+		return nil
+	}
+	if newAnalysis != "" {
+		if err := json.Unmarshal([]byte(newAnalysis), &newDefinitions); err != nil {
+			return nil
+		}
+	}
+	removed := make([]string, 0)
+	for name := range oldDefinitions {
+		if _, ok := newDefinitions[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// updateClosedIndexSettings closes the index to update the settings which can't be updated on an open index, and
+// reopens it, waiting for the configured number of active shards.
+func updateClosedIndexSettings(ctx context.Context, client *clients.ApiClient, d *schema.ResourceData, indexName string, settings map[string]interface{}) diag.Diagnostics {
+	waitForActiveShards := d.Get("wait_for_active_shards").(string)
+
+	tflog.Info(ctx, "Closing the index to update static settings", map[string]interface{}{"index": indexName})
+	if diags := elasticsearch.CloseIndex(ctx, client, indexName); diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.UpdateIndexSettings(ctx, client, indexName, settings); diags.HasError() {
+		// The index must not be left closed because of invalid settings
+		if openDiags := elasticsearch.OpenIndex(context.WithoutCancel(ctx), client, indexName, waitForActiveShards); openDiags.HasError() {
+			return append(diags, reopenIndexFailedDiags(indexName, openDiags)...)
+		}
+		return diags
+	}
+
+	diags := elasticsearch.OpenIndex(ctx, client, indexName, waitForActiveShards)
+	if diags.HasError() {
+		return reopenIndexFailedDiags(indexName, diags)
+	}
+	tflog.Info(ctx, "Index reopened with updated static settings", map[string]interface{}{"index": indexName})
+	return diags
+}
+
+func reopenIndexFailedDiags(indexName string, diags diag.Diagnostics) diag.Diagnostics {
+	return append(diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf(`The index "%s" is closed and couldn't be reopened`, indexName),
+		Detail:   fmt.Sprintf("The index can't be searched or written to until it is opened. Once the issue is fixed, open it again with `POST /%s/_open`.", indexName),
+	}}, diags...)
+}
@@ -0,0 +1,58 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExpandChangedClosedIndexSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceIndex().Schema, map[string]interface{}{
+		"name":                 "logs",
+		"codec":                "best_compression",
+		"number_of_replicas":   2,
+		"analysis_analyzer":    `{"text":{"type":"custom","tokenizer":"whitespace"}}`,
+		"analysis_char_filter": `{"strip":{"type":"html_strip"}}`,
+	})
+
+	require.Equal(t, map[string]interface{}{
+		"codec": "best_compression",
+		"analysis": map[string]interface{}{
+			"analyzer":    map[string]interface{}{"text": map[string]interface{}{"type": "custom", "tokenizer": "whitespace"}},
+			"char_filter": map[string]interface{}{"strip": map[string]interface{}{"type": "html_strip"}},
+		},
+	}, expandChangedClosedIndexSettings(d))
+}
+
+func Test_IsNullInConfig(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"mapping_coerce": cty.False,
+		"codec":          cty.NullVal(cty.String),
+	})
+
+	null, ok := isNullInConfig(config, "mapping_coerce")
+	require.True(t, ok)
+	require.False(t, null, "false must not be considered as removed")
+
+	null, ok = isNullInConfig(config, "codec")
+	require.True(t, ok)
+	require.True(t, null)
+
+	_, ok = isNullInConfig(config, "unknown")
+	require.False(t, ok)
+
+	_, ok = isNullInConfig(cty.NullVal(config.Type()), "codec")
+	require.False(t, ok)
+}
+
+func Test_RemovedAnalysisDefinitions(t *testing.T) {
+	oldAnalysis := `{"text":{"type":"custom","tokenizer":"whitespace"},"code":{"type":"keyword"}}`
+
+	require.Empty(t, removedAnalysisDefinitions(oldAnalysis, `{"text":{"type":"custom","tokenizer":"standard"},"code":{"type":"keyword"}}`))
+	require.Empty(t, removedAnalysisDefinitions(oldAnalysis, `{"text":{"type":"standard"},"code":{"type":"keyword"},"other":{"type":"simple"}}`))
+	require.Equal(t, []string{"code"}, removedAnalysisDefinitions(oldAnalysis, `{"text":{"type":"standard"}}`))
+	require.Equal(t, []string{"code", "text"}, removedAnalysisDefinitions(oldAnalysis, ""))
+	require.Empty(t, removedAnalysisDefinitions("", `{"text":{"type":"standard"}}`))
+}
@@ -2,8 +2,10 @@ package index_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
@@ -164,6 +166,279 @@ func TestAccResourceIndexRemovingField(t *testing.T) {
 	})
 }
 
+func TestAccResourceIndexReindexMigration(t *testing.T) {
+	indexName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceIndexDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIndexReindexMigration(indexName, "keyword"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "name", indexName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "concrete_name", indexName),
+				),
+			},
+			{
+				PreConfig: func() { indexTestDocument(t, indexName) },
+				Config:    testAccResourceIndexReindexMigration(indexName, "long"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "name", indexName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "concrete_name", indexName+"-v2"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "alias.0.name", indexName+"-alias"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "mappings", `{"properties":{"code":{"type":"long"}}}`),
+					checkIndexDocumentCount(indexName+"-alias", 1),
+					checkIndexDoesNotExist(indexName),
+				),
+			},
+			{
+				// Importing the successor index keeps the configured name
+				Config:            testAccResourceIndexReindexMigration(indexName, "long"),
+				ResourceName:      "elasticstack_elasticsearch_index.test",
+				ImportState:       true,
+				ImportStateIdFunc: importIndexStateId(""),
+				ImportStateCheck:  checkImportedIndexNames(indexName, indexName+"-v2"),
+			},
+			{
+				// The index can also be imported through its alias
+				Config:            testAccResourceIndexReindexMigration(indexName, "long"),
+				ResourceName:      "elasticstack_elasticsearch_index.test",
+				ImportState:       true,
+				ImportStateIdFunc: importIndexStateId(indexName + "-alias"),
+				ImportStateCheck:  checkImportedIndexNames(indexName, indexName+"-v2"),
+			},
+		},
+	})
+}
+
+// importIndexStateId returns the ID of the index in the state, with the index replaced by resourceId when it's set.
+func importIndexStateId(resourceId string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources["elasticstack_elasticsearch_index.test"]
+		if !ok {
+			return "", fmt.Errorf("index not found in the state")
+		}
+		if resourceId == "" {
+			return rs.Primary.ID, nil
+		}
+		compId, diags := clients.CompositeIdFromStr(rs.Primary.ID)
+		if diags.HasError() {
+			return "", fmt.Errorf("failed to parse the index ID: %v", diags)
+		}
+		compId.ResourceId = resourceId
+		return compId.String(), nil
+	}
+}
+
+func checkImportedIndexNames(name, concreteName string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported index, got %d", len(states))
+		}
+		attributes := states[0].Attributes
+		if attributes["name"] != name {
+			return fmt.Errorf(`expected the imported index to be named "%s", got "%s"`, name, attributes["name"])
+		}
+		if attributes["concrete_name"] != concreteName {
+			return fmt.Errorf(`expected the imported concrete index to be "%s", got "%s"`, concreteName, attributes["concrete_name"])
+		}
+		return nil
+	}
+}
+
+func TestAccResourceIndexStaticSettingsUpdate(t *testing.T) {
+	indexName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceIndexDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIndexStaticSettings(indexName, "", "standard"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "allow_close_for_static_settings", "true"),
+				),
+			},
+			{
+				PreConfig: func() { indexTestDocument(t, indexName) },
+				Config:    testAccResourceIndexStaticSettings(indexName, "best_compression", "whitespace"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "codec", "best_compression"),
+					resource.TestMatchResourceAttr("elasticstack_elasticsearch_index.test", "settings_raw", regexp.MustCompile(`"index.codec":"best_compression"`)),
+					resource.TestMatchResourceAttr("elasticstack_elasticsearch_index.test", "settings_raw", regexp.MustCompile(`"index.analysis.analyzer.text.tokenizer":"whitespace"`)),
+					// The index has been reopened and kept its documents
+					checkIndexDocumentCount(indexName, 1),
+				),
+			},
+			{
+				Config: testAccResourceIndexStaticSettingsCoerce(indexName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "mapping_coerce", "false"),
+					resource.TestMatchResourceAttr("elasticstack_elasticsearch_index.test", "settings_raw", regexp.MustCompile(`"index.mapping.coerce":"false"`)),
+				),
+			},
+			{
+				// Elasticsearch keeps the analyzers removed from the analysis settings
+				Config:      testAccResourceIndexStaticSettingsCoerce(indexName, true),
+				ExpectError: regexp.MustCompile("`analysis_analyzer` definitions can't be removed"),
+			},
+		},
+	})
+}
+
+func testAccResourceIndexStaticSettingsCoerce(name string, removeAnalyzer bool) string {
+	analyzer := `
+  analysis_analyzer = jsonencode({
+    text = {
+      type      = "custom"
+      tokenizer = "whitespace"
+    }
+  })
+`
+	if removeAnalyzer {
+		analyzer = ""
+	}
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name                            = "%s"
+  allow_close_for_static_settings = true
+  codec                           = "best_compression"
+  mapping_coerce                  = false
+%s
+  deletion_protection = false
+}
+	`, name, analyzer)
+}
+
+func testAccResourceIndexReindexMigration(name, fieldType string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name               = "%[1]s"
+  migration_strategy = "reindex"
+
+  alias {
+    name = "%[1]s-alias"
+  }
+
+  mappings = jsonencode({
+    properties = {
+      code = { type = "%[2]s" }
+    }
+  })
+
+  deletion_protection = false
+}
+	`, name, fieldType)
+}
+
+func testAccResourceIndexStaticSettings(name, codec, tokenizer string) string {
+	codecAttr := ""
+	if codec != "" {
+		codecAttr = fmt.Sprintf("codec = %q", codec)
+	}
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name                            = "%s"
+  allow_close_for_static_settings = true
+  %s
+
+  analysis_analyzer = jsonencode({
+    text = {
+      type      = "custom"
+      tokenizer = "%s"
+    }
+  })
+
+  deletion_protection = false
+}
+	`, name, codecAttr, tokenizer)
+}
+
+func indexTestDocument(t *testing.T, indexName string) {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	esClient, err := client.GetESClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := esClient.Index(indexName, strings.NewReader(`{"code":"42"}`), esClient.Index.WithRefresh("true"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		t.Fatalf("unable to index the test document: %s", res.String())
+	}
+}
+
+func checkIndexDocumentCount(indexName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingClient()
+		if err != nil {
+			return err
+		}
+		esClient, err := client.GetESClient()
+		if err != nil {
+			return err
+		}
+		res, err := esClient.Count(esClient.Count.WithIndex(indexName))
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		var count struct {
+			Count int `json:"count"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&count); err != nil {
+			return err
+		}
+		if count.Count != expected {
+			return fmt.Errorf("expected %d documents in %s, got %d", expected, indexName, count.Count)
+		}
+		return nil
+	}
+}
+
+func checkIndexDoesNotExist(indexName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingClient()
+		if err != nil {
+			return err
+		}
+		esClient, err := client.GetESClient()
+		if err != nil {
+			return err
+		}
+		res, err := esClient.Indices.Get([]string{indexName})
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != 404 {
+			return fmt.Errorf("Index (%s) still exists", indexName)
+		}
+		return nil
+	}
+}
+
 func testAccResourceIndexCreate(name string) string {
 	return fmt.Sprintf(`
 provider "elasticstack" {
@@ -191,93 +191,93 @@ func resourceIndexTemplatePut(ctx context.Context, d *schema.ResourceData, meta
 	if diags.HasError() {
 		return diags
 	}
-	var indexTemplate models.IndexTemplate
+	// 8.x workaround
+	hasAllowCustomRouting := false
+	if d.HasChange("data_stream") {
+		old, _ := d.GetChange("data_stream")
+
+		if old != nil && len(old.([]interface{})) == 1 {
+			if old.([]interface{})[0] != nil {
+				setting := old.([]interface{})[0].(map[string]interface{})
+				if acr, ok := setting["allow_custom_routing"]; ok && acr.(bool) {
+					hasAllowCustomRouting = true
+				}
+			}
+		}
+	}
+
+	config := make(map[string]interface{})
+	for _, key := range []string{"composed_of", "data_stream", "index_patterns", "metadata", "priority", "template", "version"} {
+		config[key] = d.Get(key)
+	}
+	indexTemplate, diags := expandIndexTemplate(config, hasAllowCustomRouting)
+	if diags.HasError() {
+		return diags
+	}
 	indexTemplate.Name = templateId
 
-	compsOf := make([]string, 0)
-	if v, ok := d.GetOk("composed_of"); ok {
-		for _, c := range v.([]interface{}) {
-			compsOf = append(compsOf, c.(string))
-		}
+	if diags := elasticsearch.PutIndexTemplate(ctx, client, indexTemplate); diags.HasError() {
+		return diags
 	}
-	indexTemplate.ComposedOf = compsOf
 
-	if v, ok := d.GetOk("data_stream"); ok {
-		// 8.x workaround
-		hasAllowCustomRouting := false
-		if d.HasChange("data_stream") {
-			old, _ := d.GetChange("data_stream")
+	d.SetId(id.String())
+	return resourceIndexTemplateRead(ctx, d, meta)
+}
 
-			if old != nil && len(old.([]interface{})) == 1 {
-				if old.([]interface{})[0] != nil {
-					setting := old.([]interface{})[0].(map[string]interface{})
-					if acr, ok := setting["allow_custom_routing"]; ok && acr.(bool) {
-						hasAllowCustomRouting = true
-					}
-				}
-			}
-		}
+// expandIndexTemplate builds the put request of an index template from its configuration.
+// allow_custom_routing is only sent when enabled, or when it was enabled before, since it isn't supported in 7.x
+func expandIndexTemplate(config map[string]interface{}, hasAllowCustomRouting bool) (*models.IndexTemplate, diag.Diagnostics) {
+	indexTemplate := models.IndexTemplate{
+		ComposedOf:    make([]string, 0),
+		IndexPatterns: make([]string, 0),
+	}
 
-		// only one definition of stream allowed
-		if v.([]interface{})[0] != nil {
-			stream := v.([]interface{})[0].(map[string]interface{})
-			dSettings := &models.DataStreamSettings{}
-			if s, ok := stream["hidden"]; ok {
-				hidden := s.(bool)
-				dSettings.Hidden = &hidden
-			}
-			if s, ok := stream["allow_custom_routing"]; ok && (hasAllowCustomRouting || s.(bool)) {
-				allow := s.(bool)
-				dSettings.AllowCustomRouting = &allow
-			}
-			indexTemplate.DataStream = dSettings
-		}
+	for _, c := range config["composed_of"].([]interface{}) {
+		indexTemplate.ComposedOf = append(indexTemplate.ComposedOf, c.(string))
+	}
+	for _, p := range config["index_patterns"].(*schema.Set).List() {
+		indexTemplate.IndexPatterns = append(indexTemplate.IndexPatterns, p.(string))
 	}
 
-	if v, ok := d.GetOk("index_patterns"); ok {
-		definedIndPats := v.(*schema.Set)
-		indPats := make([]string, definedIndPats.Len())
-		for i, p := range definedIndPats.List() {
-			indPats[i] = p.(string)
+	// only one definition of stream allowed
+	if v := config["data_stream"].([]interface{}); len(v) > 0 && v[0] != nil {
+		stream := v[0].(map[string]interface{})
+		dSettings := &models.DataStreamSettings{}
+		hidden := stream["hidden"].(bool)
+		dSettings.Hidden = &hidden
+		if allow := stream["allow_custom_routing"].(bool); allow || hasAllowCustomRouting {
+			dSettings.AllowCustomRouting = &allow
 		}
-		indexTemplate.IndexPatterns = indPats
+		indexTemplate.DataStream = dSettings
 	}
 
-	if v, ok := d.GetOk("metadata"); ok {
+	if v := config["metadata"].(string); v != "" {
 		metadata := make(map[string]interface{})
-		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&metadata); err != nil {
-			return diag.FromErr(err)
+		if err := json.NewDecoder(strings.NewReader(v)).Decode(&metadata); err != nil {
+			return nil, diag.FromErr(err)
 		}
 		indexTemplate.Meta = metadata
 	}
 
-	if v, ok := d.GetOk("priority"); ok {
-		definedPr := v.(int)
-		indexTemplate.Priority = &definedPr
+	if v := config["priority"].(int); v != 0 {
+		indexTemplate.Priority = &v
 	}
 
-	if v, ok := d.GetOk("template"); ok {
+	if v := config["template"].([]interface{}); len(v) > 0 {
 		templ, ok, diags := expandTemplate(v)
 		if diags != nil {
-			return diags
+			return nil, diags
 		}
-
 		if ok {
 			indexTemplate.Template = &templ
 		}
 	}
 
-	if v, ok := d.GetOk("version"); ok {
-		definedVer := v.(int)
-		indexTemplate.Version = &definedVer
+	if v := config["version"].(int); v != 0 {
+		indexTemplate.Version = &v
 	}
 
-	if diags := elasticsearch.PutIndexTemplate(ctx, client, &indexTemplate); diags.HasError() {
-		return diags
-	}
-
-	d.SetId(id.String())
-	return resourceIndexTemplateRead(ctx, d, meta)
+	return &indexTemplate, nil
 }
 
 func expandTemplate(config interface{}) (models.Template, bool, diag.Diagnostics) {
@@ -338,6 +338,11 @@ func resourceIndexTemplateRead(ctx context.Context, d *schema.ResourceData, meta
 		return diags
 	}
 
+	return setIndexTemplateData(d, tpl)
+}
+
+func setIndexTemplateData(d *schema.ResourceData, tpl *models.IndexTemplateResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
 	// set the fields
 	if err := d.Set("name", tpl.Name); err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,120 @@
+package index
+
+import (
+	"context"
+	"sort"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceTemplate() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves an existing index template. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-get-template.html",
+
+		ReadContext: dataSourceIndexTemplateRead,
+
+		Schema: dataSourceSchemaFromResource(ResourceTemplate().Schema, "Name of the index template."),
+	}
+}
+
+func dataSourceIndexTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	templateName := d.Get("name").(string)
+
+	tpl, diags := elasticsearch.GetIndexTemplate(ctx, client, templateName)
+	if diags.HasError() {
+		return diags
+	}
+	if tpl == nil {
+		return diag.Errorf(`Index template "%s" not found`, templateName)
+	}
+
+	id, diags := client.ID(ctx, templateName)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	return setIndexTemplateData(d, tpl)
+}
+
+func DataSourceTemplates() *schema.Resource {
+	templatesSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name_pattern": {
+			Description: "Only return the index templates whose name matches this pattern. Supports the `*` wildcard and comma-separated lists.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "*",
+		},
+		"index_templates": {
+			Description: "The matching index templates, sorted by name.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        utils.ListElemSchema(DataSourceTemplate().Schema),
+		},
+	}
+
+	utils.AddConnectionSchema(templatesSchema)
+
+	return &schema.Resource{
+		Description: "Lists the index templates matching a pattern. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-get-template.html",
+
+		ReadContext: dataSourceIndexTemplatesRead,
+
+		Schema: templatesSchema,
+	}
+}
+
+func dataSourceIndexTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	pattern := d.Get("name_pattern").(string)
+
+	templates, diags := elasticsearch.GetIndexTemplates(ctx, client, pattern)
+	if diags.HasError() {
+		return diags
+	}
+
+	templateElem := DataSourceTemplates().Schema["index_templates"].Elem.(*schema.Resource)
+	result := make([]interface{}, 0)
+	if templates != nil {
+		sort.Slice(templates.IndexTemplates, func(i, j int) bool {
+			return templates.IndexTemplates[i].Name < templates.IndexTemplates[j].Name
+		})
+		for i := range templates.IndexTemplates {
+			tpl := &templates.IndexTemplates[i]
+			flattened, diags := flattenListElem(templateElem, func(d *schema.ResourceData) diag.Diagnostics {
+				return setIndexTemplateData(d, tpl)
+			})
+			if diags.HasError() {
+				return diags
+			}
+			result = append(result, flattened)
+		}
+	}
+
+	id, diags := client.ID(ctx, pattern)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if err := d.Set("index_templates", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
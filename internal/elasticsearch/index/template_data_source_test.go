@@ -0,0 +1,68 @@
+package index_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIndexTemplate(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIndexTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_template.test", "name", name+"-a"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_template.test", "priority", "42"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_index_template.test", "index_patterns.*", name+"-a-*"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_template.test", "template.0.alias.0.name", name+"-alias"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_templates.test", "index_templates.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_templates.test", "index_templates.0.name", name+"-a"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_templates.test", "index_templates.1.name", name+"-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIndexTemplate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index_template" "a" {
+  name           = "%[1]s-a"
+  index_patterns = ["%[1]s-a-*"]
+  priority       = 42
+
+  template {
+    alias {
+      name = "%[1]s-alias"
+    }
+  }
+}
+
+resource "elasticstack_elasticsearch_index_template" "b" {
+  name           = "%[1]s-b"
+  index_patterns = ["%[1]s-b-*"]
+}
+
+data "elasticstack_elasticsearch_index_template" "test" {
+  name = elasticstack_elasticsearch_index_template.a.name
+}
+
+data "elasticstack_elasticsearch_index_templates" "test" {
+  name_pattern = "%[1]s-*"
+
+  depends_on = [elasticstack_elasticsearch_index_template.a, elasticstack_elasticsearch_index_template.b]
+}
+	`, name)
+}
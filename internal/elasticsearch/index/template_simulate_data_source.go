@@ -0,0 +1,155 @@
+package index
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceTemplateSimulate() *schema.Resource {
+	// The unsaved index template is configured like the index template resource
+	indexTemplateSchema := ResourceTemplate().Schema
+	delete(indexTemplateSchema, "id")
+	delete(indexTemplateSchema, "name")
+	delete(indexTemplateSchema, "elasticsearch_connection")
+
+	aliasSchema := ResourceTemplate().Schema["template"].Elem.(*schema.Resource).Schema["alias"]
+	utils.ToComputedSchema(aliasSchema)
+	aliasSchema.Description = "The aliases the index would be added to."
+
+	simulateSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"index_name": {
+			Description: "Name of the index to simulate the creation of.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"index_template": {
+			Description: "An index template which doesn't exist yet, included in the simulation as if it existed. Its arguments are the same as the `elasticstack_elasticsearch_index_template` resource ones.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: indexTemplateSchema,
+			},
+		},
+		"settings": {
+			Description: "The resolved settings of the index, as JSON.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"mappings": {
+			Description: "The resolved mappings of the index, as JSON.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"alias": aliasSchema,
+		"overlapping": {
+			Description: "The index templates matching the index which have been superseded by a template with a higher priority.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "Name of the index template.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"index_patterns": {
+						Description: "The index patterns of the index template.",
+						Type:        schema.TypeSet,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(simulateSchema)
+
+	return &schema.Resource{
+		Description: "Returns the settings, mappings and aliases an index would get from the matching index templates, and from an optional index template which doesn't exist yet. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-simulate-index.html",
+
+		ReadContext: dataSourceTemplateSimulateRead,
+
+		Schema: simulateSchema,
+	}
+}
+
+func dataSourceTemplateSimulateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	indexName := d.Get("index_name").(string)
+
+	var indexTemplate *models.IndexTemplate
+	if v, ok := d.GetOk("index_template"); ok && v.([]interface{})[0] != nil {
+		indexTemplate, diags = expandIndexTemplate(v.([]interface{})[0].(map[string]interface{}), false)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	simulated, diags := elasticsearch.SimulateIndexTemplate(ctx, client, indexName, indexTemplate)
+	if diags.HasError() {
+		return diags
+	}
+
+	id, diags := client.ID(ctx, indexName)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	settings, err := json.Marshal(simulated.Template.Settings)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("settings", string(settings)); err != nil {
+		return diag.FromErr(err)
+	}
+	mappings, err := json.Marshal(simulated.Template.Mappings)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("mappings", string(mappings)); err != nil {
+		return diag.FromErr(err)
+	}
+	aliases, diags := FlattenIndexAliases(simulated.Template.Aliases)
+	if diags.HasError() {
+		return diags
+	}
+	if err := d.Set("alias", aliases); err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.Slice(simulated.Overlapping, func(i, j int) bool {
+		return simulated.Overlapping[i].Name < simulated.Overlapping[j].Name
+	})
+	overlapping := make([]interface{}, len(simulated.Overlapping))
+	for i, template := range simulated.Overlapping {
+		overlapping[i] = map[string]interface{}{
+			"name":           template.Name,
+			"index_patterns": template.IndexPatterns,
+		}
+	}
+	if err := d.Set("overlapping", overlapping); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package index_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIndexTemplateSimulate(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIndexTemplateSimulate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.existing", "settings", regexp.MustCompile(`"number_of_replicas":"2"`)),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.existing", "mappings", regexp.MustCompile(`"code":\{"type":"keyword"\}`)),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.existing", "alias.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.existing", "alias.0.name", name+"-alias"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.existing", "overlapping.#", "0"),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.unsaved", "settings", regexp.MustCompile(`"number_of_replicas":"0"`)),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.unsaved", "overlapping.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.unsaved", "overlapping.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIndexTemplateSimulate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_component_template" "test" {
+  name = "%[1]s-mappings"
+
+  template {
+    mappings = jsonencode({
+      properties = {
+        code = { type = "keyword" }
+      }
+    })
+  }
+}
+
+resource "elasticstack_elasticsearch_index_template" "test" {
+  name           = "%[1]s"
+  index_patterns = ["%[1]s-*"]
+  composed_of    = [elasticstack_elasticsearch_component_template.test.name]
+  priority       = 100
+
+  template {
+    alias {
+      name = "%[1]s-alias"
+    }
+    settings = jsonencode({
+      number_of_replicas = 2
+    })
+  }
+}
+
+data "elasticstack_elasticsearch_index_template_simulate" "existing" {
+  index_name = "%[1]s-1"
+
+  depends_on = [elasticstack_elasticsearch_index_template.test]
+}
+
+data "elasticstack_elasticsearch_index_template_simulate" "unsaved" {
+  index_name = "%[1]s-1"
+
+  index_template {
+    index_patterns = ["%[1]s-1*"]
+    priority       = 200
+
+    template {
+      settings = jsonencode({
+        number_of_replicas = 0
+      })
+    }
+  }
+
+  depends_on = [elasticstack_elasticsearch_index_template.test]
+}
+	`, name)
+}
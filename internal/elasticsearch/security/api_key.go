@@ -3,8 +3,10 @@ package security
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
@@ -16,7 +18,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var APIKeyMinVersion = version.Must(version.NewVersion("8.0.0")) // Enabled in 8.0
+var (
+	APIKeyMinVersion                 = version.Must(version.NewVersion("8.0.0"))  // Enabled in 8.0
+	APIKeyUpdateMinVersion           = version.Must(version.NewVersion("8.4.0"))  // Update API added in 8.4
+	APIKeyUpdateExpirationMinVersion = version.Must(version.NewVersion("8.13.0")) // Expiration can be updated since 8.13
+	CrossClusterAPIKeyMinVersion     = version.Must(version.NewVersion("8.10.0")) // Cross cluster API keys added in 8.10
+)
+
+const (
+	apiKeyTypeRest         = "rest"
+	apiKeyTypeCrossCluster = "cross_cluster"
+)
 
 func ResourceApiKey() *schema.Resource {
 	apikeySchema := map[string]*schema.Schema{
@@ -35,19 +47,109 @@ func ResourceApiKey() *schema.Resource {
 				validation.StringMatch(regexp.MustCompile(`^([[:graph:]]| )+$`), "must contain alphanumeric characters (a-z, A-Z, 0-9), spaces, punctuation, and printable symbols in the Basic Latin (ASCII) block. Leading or trailing whitespace is not allowed"),
 			),
 		},
+		"type": {
+			Description:  "The type of the API key, either `rest` or `cross_cluster`. Cross cluster API keys are used for the API key based security model of remote clusters.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      apiKeyTypeRest,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{apiKeyTypeRest, apiKeyTypeCrossCluster}, false),
+		},
 		"role_descriptors": {
-			Description:      "Role descriptors for this API key.",
+			Description:      "Role descriptors for this API key. Only valid for `rest` API keys.",
 			Type:             schema.TypeString,
 			Optional:         true,
-			ForceNew:         true,
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: utils.DiffJsonSuppress,
 		},
+		"access": {
+			Description: "The access to be granted to a `cross_cluster` API key. At least one of `search` or `replication` must be set.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"search": {
+						Description: "A list of indices permissions entries for cross cluster search.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"names": {
+									Description: "A list of indices (or index name patterns) to which the permissions in this entry apply.",
+									Type:        schema.TypeList,
+									Required:    true,
+									MinItems:    1,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"field_security": {
+									Description: "The document fields that the owners of the API key have read access to.",
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"grant": {
+												Description: "List of the fields to grant the access to.",
+												Type:        schema.TypeSet,
+												Optional:    true,
+												Elem: &schema.Schema{
+													Type: schema.TypeString,
+												},
+											},
+											"except": {
+												Description: "List of the fields to which the grants will not be applied.",
+												Type:        schema.TypeSet,
+												Optional:    true,
+												Elem: &schema.Schema{
+													Type: schema.TypeString,
+												},
+											},
+										},
+									},
+								},
+								"query": {
+									Description:      "A search query that defines the documents the owners of the API key have read access to.",
+									Type:             schema.TypeString,
+									Optional:         true,
+									ValidateFunc:     validation.StringIsJSON,
+									DiffSuppressFunc: utils.DiffJsonSuppress,
+								},
+								"allow_restricted_indices": {
+									Description: "Include matching restricted indices in names parameter. Usage is strongly discouraged as it can grant unrestricted operations on critical data, make the entire system unstable or leak sensitive information.",
+									Type:        schema.TypeBool,
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"replication": {
+						Description: "A list of indices permissions entries for cross cluster replication.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"names": {
+									Description: "A list of indices (or index name patterns) to which the permissions in this entry apply.",
+									Type:        schema.TypeList,
+									Required:    true,
+									MinItems:    1,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"expiration": {
-			Description: "Expiration time for the API key. By default, API keys never expire.",
+			Description: "Expiration time for the API key. By default, API keys never expire. Updating the expiration requires Elasticsearch 8.13 or later.",
 			Type:        schema.TypeString,
 			Optional:    true,
-			ForceNew:    true,
 		},
 		"expiration_timestamp": {
 			Description: "Expiration time in milliseconds for the API key. By default, API keys never expire.",
@@ -59,10 +161,38 @@ func ResourceApiKey() *schema.Resource {
 			Type:             schema.TypeString,
 			Optional:         true,
 			Computed:         true,
-			ForceNew:         true,
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: utils.DiffJsonSuppress,
 		},
+		"rotation_period": {
+			Description:  "How often the API key is rotated, as a Go duration (e.g. `720h`). Once the current key is older than the rotation period, the next apply creates a new key.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: utils.StringIsDuration,
+		},
+		"overlap": {
+			Description:  "How long the previous API key stays valid after a rotation, as a Go duration (e.g. `24h`). The previous key is invalidated on the first apply after the overlap window. By default, the previous key is invalidated as part of the rotation.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: utils.StringIsDuration,
+			RequiredWith: []string{"rotation_period"},
+		},
+		"creation_timestamp": {
+			Description: "Creation time in milliseconds of the current API key.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"previous_key_id": {
+			Description: "The identifier of the previous API key, while it is still valid during the overlap window.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"previous_encoded": {
+			Description: "API key credentials of the previous API key, while it is still valid during the overlap window.",
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Computed:    true,
+		},
 		"api_key": {
 			Description: "Generated API Key.",
 			Type:        schema.TypeString,
@@ -87,45 +217,131 @@ func ResourceApiKey() *schema.Resource {
 		ReadContext:   resourceSecurityApiKeyRead,
 		DeleteContext: resourceSecurityApiKeyDelete,
 
+		CustomizeDiff: resourceSecurityApiKeyCustomizeDiff,
+
 		Schema: apikeySchema,
 	}
 }
 
+func resourceSecurityApiKeyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	switch d.Get("type").(string) {
+	case apiKeyTypeCrossCluster:
+		if _, ok := d.GetOk("role_descriptors"); ok {
+			return fmt.Errorf("role_descriptors cannot be set on a %s API key, use access instead", apiKeyTypeCrossCluster)
+		}
+		if _, ok := d.GetOk("access"); !ok {
+			return fmt.Errorf("access must be set on a %s API key", apiKeyTypeCrossCluster)
+		}
+	default:
+		if _, ok := d.GetOk("access"); ok {
+			return fmt.Errorf("access can only be set on a %s API key", apiKeyTypeCrossCluster)
+		}
+	}
+
+	if d.Id() == "" || d.HasChange("type") {
+		return nil
+	}
+
+	now := time.Now()
+	creation := int64(d.Get("creation_timestamp").(int))
+
+	// A new API key is created once the current one is older than the rotation period.
+	if period := d.Get("rotation_period").(string); period != "" && creation > 0 && isPastDuration(creation, period, now) {
+		for _, key := range []string{"id", "api_key", "encoded", "expiration_timestamp", "creation_timestamp", "previous_key_id", "previous_encoded"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// The previous API key is invalidated once the overlap window has passed.
+	if d.Get("previous_key_id").(string) != "" && isPastDuration(creation, d.Get("overlap").(string), now) {
+		if err := d.SetNew("previous_key_id", ""); err != nil {
+			return err
+		}
+		if err := d.SetNew("previous_encoded", ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isPastDuration returns whether the duration has elapsed since the timestamp, in milliseconds.
+func isPastDuration(timestamp int64, duration string, now time.Time) bool {
+	var d time.Duration
+	if duration != "" {
+		var err error
+		if d, err = time.ParseDuration(duration); err != nil {
+			return false
+		}
+	}
+	return !now.Before(time.UnixMilli(timestamp).Add(d))
+}
+
 func resourceSecurityApiKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClientFromSDKResource(d, meta)
 	if diags.HasError() {
 		return diags
 	}
 
+	if diags := createApiKey(ctx, client, d); diags.HasError() {
+		return diags
+	}
+
+	return resourceSecurityApiKeyRead(ctx, d, meta)
+}
+
+// createApiKey creates a new API key from the resource configuration, and sets its identifier and credentials.
+func createApiKey(ctx context.Context, client *clients.ApiClient, d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
 	nameId := d.Get("name").(string)
 
-	var apikey models.ApiKey
-	apikey.Name = nameId
+	var putResponse *models.ApiKeyResponse
+	if d.Get("type").(string) == apiKeyTypeCrossCluster {
+		if diags := enforceApiKeyMinVersion(ctx, client, CrossClusterAPIKeyMinVersion, "Cross cluster API keys"); diags.HasError() {
+			return diags
+		}
 
-	if v, ok := d.GetOk("expiration"); ok {
-		apikey.Expiration = v.(string)
-	}
+		apikey, diags := expandCrossClusterApiKey(d)
+		if diags.HasError() {
+			return diags
+		}
+		apikey.Name = nameId
 
-	if v, ok := d.GetOk("role_descriptors"); ok {
-		role_descriptors := map[string]models.Role{}
-		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&role_descriptors); err != nil {
-			return diag.FromErr(err)
+		putResponse, diags = elasticsearch.CreateCrossClusterApiKey(ctx, client, apikey)
+		if diags.HasError() {
+			return diags
 		}
-		apikey.RolesDescriptors = role_descriptors
-	}
+	} else {
+		var apikey models.ApiKey
+		apikey.Name = nameId
 
-	if v, ok := d.GetOk("metadata"); ok {
-		metadata := make(map[string]interface{})
-		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&metadata); err != nil {
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("expiration"); ok {
+			apikey.Expiration = v.(string)
 		}
-		apikey.Metadata = metadata
-	}
 
-	putResponse, diags := elasticsearch.PutApiKey(client, &apikey)
+		if v, ok := d.GetOk("role_descriptors"); ok {
+			role_descriptors := map[string]models.Role{}
+			if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&role_descriptors); err != nil {
+				return diag.FromErr(err)
+			}
+			apikey.RolesDescriptors = role_descriptors
+		}
 
-	if diags.HasError() {
-		return diags
+		if v, ok := d.GetOk("metadata"); ok {
+			metadata := make(map[string]interface{})
+			if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&metadata); err != nil {
+				return diag.FromErr(err)
+			}
+			apikey.Metadata = metadata
+		}
+
+		putResponse, diags = elasticsearch.PutApiKey(client, &apikey)
+		if diags.HasError() {
+			return diags
+		}
 	}
 
 	id, diags := client.ID(ctx, putResponse.Id)
@@ -147,22 +363,229 @@ func resourceSecurityApiKeyCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("expiration", apikey.Expiration); err != nil {
+	if err := d.Set("expiration", d.Get("expiration").(string)); err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(id.String())
-	return resourceSecurityApiKeyRead(ctx, d, meta)
+	return nil
+}
+
+// rotateApiKey replaces the current API key with a new one. The current key stays valid as the previous key when an
+// overlap is configured, any key left over from an earlier rotation is invalidated.
+func rotateApiKey(ctx context.Context, client *clients.ApiClient, d *schema.ResourceData, currentId string) diag.Diagnostics {
+	if previousId, _ := d.GetChange("previous_key_id"); previousId.(string) != "" {
+		if diags := elasticsearch.DeleteApiKey(client, previousId.(string)); diags.HasError() {
+			return diags
+		}
+	}
+
+	currentEncoded, _ := d.GetChange("encoded")
+	if diags := createApiKey(ctx, client, d); diags.HasError() {
+		return diags
+	}
+
+	previousId, previousEncoded := "", ""
+	if d.Get("overlap").(string) != "" {
+		previousId, previousEncoded = currentId, currentEncoded.(string)
+	} else if diags := elasticsearch.DeleteApiKey(client, currentId); diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("previous_key_id", previousId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("previous_encoded", previousEncoded); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
 func resourceSecurityApiKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	diags := diag.Diagnostics{diag.Diagnostic{
-		Severity: diag.Error,
-		Summary:  `Cannot update API Key`,
-		Detail:   `update not currently supported.`,
-	}}
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
 
-	return diags
+	// The credentials are only unknown in the plan when the API key is due for rotation.
+	if d.HasChange("encoded") {
+		if diags := rotateApiKey(ctx, client, d, compId.ResourceId); diags.HasError() {
+			return diags
+		}
+		return resourceSecurityApiKeyRead(ctx, d, meta)
+	}
+
+	if previousId, _ := d.GetChange("previous_key_id"); previousId.(string) != "" && d.Get("previous_key_id").(string) == "" {
+		if diags := elasticsearch.DeleteApiKey(client, previousId.(string)); diags.HasError() {
+			return diags
+		}
+		if err := d.Set("previous_encoded", ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if !d.HasChanges("role_descriptors", "access", "metadata", "expiration") {
+		return resourceSecurityApiKeyRead(ctx, d, meta)
+	}
+
+	if diags := enforceApiKeyMinVersion(ctx, client, APIKeyUpdateMinVersion, "Updating API keys"); diags.HasError() {
+		return diags
+	}
+
+	// The expiration of an existing API key can only be changed from 8.13 onwards.
+	expiration := ""
+	if d.HasChange("expiration") {
+		if diags := enforceApiKeyMinVersion(ctx, client, APIKeyUpdateExpirationMinVersion, "Updating the expiration of API keys"); diags.HasError() {
+			return diags
+		}
+		expiration = d.Get("expiration").(string)
+	}
+
+	var metadata *map[string]interface{}
+	if d.HasChange("metadata") {
+		m := make(map[string]interface{})
+		if v, ok := d.GetOk("metadata"); ok {
+			if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&m); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		metadata = &m
+	}
+
+	if d.Get("type").(string) == apiKeyTypeCrossCluster {
+		apikey, diags := expandCrossClusterApiKey(d)
+		if diags.HasError() {
+			return diags
+		}
+		apikey.Expiration = expiration
+		apikey.Metadata = metadata
+
+		if diags := elasticsearch.UpdateCrossClusterApiKey(ctx, client, compId.ResourceId, apikey); diags.HasError() {
+			return diags
+		}
+	} else {
+		apikey := models.ApiKeyUpdate{
+			Expiration: expiration,
+			Metadata:   metadata,
+		}
+
+		if d.HasChange("role_descriptors") {
+			// An empty set of role descriptors gives the API key the privileges of its owner.
+			roleDescriptors := map[string]models.Role{}
+			if v, ok := d.GetOk("role_descriptors"); ok {
+				if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&roleDescriptors); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			apikey.RolesDescriptors = &roleDescriptors
+		}
+
+		if diags := elasticsearch.UpdateApiKey(ctx, client, compId.ResourceId, &apikey); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceSecurityApiKeyRead(ctx, d, meta)
+}
+
+func enforceApiKeyMinVersion(ctx context.Context, client *clients.ApiClient, minVersion *version.Version, feature string) diag.Diagnostics {
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	if serverVersion.LessThan(minVersion) {
+		return diag.Errorf("%s is only supported from Elasticsearch version %s", feature, minVersion)
+	}
+	return nil
+}
+
+func expandCrossClusterApiKey(d *schema.ResourceData) (*models.CrossClusterApiKey, diag.Diagnostics) {
+	var apikey models.CrossClusterApiKey
+	if v, ok := d.GetOk("expiration"); ok {
+		apikey.Expiration = v.(string)
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		metadata := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&metadata); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		apikey.Metadata = &metadata
+	}
+
+	access := models.CrossClusterApiKeyAccess{}
+	if v, ok := d.GetOk("access"); ok {
+		definedAccess := v.([]interface{})[0].(map[string]interface{})
+
+		for _, s := range definedAccess["search"].([]interface{}) {
+			search := s.(map[string]interface{})
+			entry := models.CrossClusterApiKeyAccessEntry{
+				Names: utils.ExpandStringList(search["names"].([]interface{})),
+			}
+
+			if query := search["query"].(string); query != "" {
+				entry.Query = &query
+			}
+			if fieldSec := search["field_security"].([]interface{}); len(fieldSec) > 0 && fieldSec[0] != nil {
+				definedFieldSec := fieldSec[0].(map[string]interface{})
+				entry.FieldSecurity = &models.FieldSecurity{
+					Grant:  utils.ExpandStringSet(definedFieldSec["grant"].(*schema.Set)),
+					Except: utils.ExpandStringSet(definedFieldSec["except"].(*schema.Set)),
+				}
+			}
+			if allowRestrictedIndices := search["allow_restricted_indices"].(bool); allowRestrictedIndices {
+				entry.AllowRestrictedIndices = &allowRestrictedIndices
+			}
+
+			access.Search = append(access.Search, entry)
+		}
+
+		for _, r := range definedAccess["replication"].([]interface{}) {
+			replication := r.(map[string]interface{})
+			access.Replication = append(access.Replication, models.CrossClusterApiKeyAccessEntry{
+				Names: utils.ExpandStringList(replication["names"].([]interface{})),
+			})
+		}
+	}
+	apikey.Access = &access
+
+	return &apikey, nil
+}
+
+func flattenCrossClusterApiKeyAccess(access *models.CrossClusterApiKeyAccess) []interface{} {
+	search := make([]interface{}, len(access.Search))
+	for i, entry := range access.Search {
+		s := map[string]interface{}{
+			"names":                    entry.Names,
+			"allow_restricted_indices": entry.AllowRestrictedIndices != nil && *entry.AllowRestrictedIndices,
+		}
+		if entry.Query != nil {
+			s["query"] = *entry.Query
+		}
+		if entry.FieldSecurity != nil {
+			s["field_security"] = []interface{}{map[string]interface{}{
+				"grant":  entry.FieldSecurity.Grant,
+				"except": entry.FieldSecurity.Except,
+			}}
+		}
+		search[i] = s
+	}
+
+	replication := make([]interface{}, len(access.Replication))
+	for i, entry := range access.Replication {
+		replication[i] = map[string]interface{}{
+			"names": entry.Names,
+		}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"search":      search,
+		"replication": replication,
+	}}
 }
 
 func resourceSecurityApiKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -197,8 +620,26 @@ func resourceSecurityApiKeyRead(ctx context.Context, d *schema.ResourceData, met
 	if err := d.Set("expiration_timestamp", apikey.Expiration); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("creation_timestamp", apikey.Creation); err != nil {
+		return diag.FromErr(err)
+	}
+
+	apiKeyType := apiKeyTypeRest
+	if apikey.Type != "" {
+		apiKeyType = apikey.Type
+	}
+	if err := d.Set("type", apiKeyType); err != nil {
+		return diag.FromErr(err)
+	}
 
-	if apikey.RolesDescriptors != nil {
+	// The role descriptors of cross cluster API keys are derived from their access.
+	if apiKeyType == apiKeyTypeCrossCluster {
+		if apikey.Access != nil {
+			if err := d.Set("access", flattenCrossClusterApiKeyAccess(apikey.Access)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	} else if apikey.RolesDescriptors != nil {
 		rolesDescriptors, err := json.Marshal(apikey.RolesDescriptors)
 		if err != nil {
 			return diag.FromErr(err)
@@ -228,6 +669,11 @@ func resourceSecurityApiKeyDelete(ctx context.Context, d *schema.ResourceData, m
 	if diags := elasticsearch.DeleteApiKey(client, compId.ResourceId); diags.HasError() {
 		return diags
 	}
+	if previousId := d.Get("previous_key_id").(string); previousId != "" {
+		if diags := elasticsearch.DeleteApiKey(client, previousId); diags.HasError() {
+			return diags
+		}
+	}
 
 	d.SetId("")
 	return diags
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
@@ -60,6 +61,131 @@ func TestAccResourceSecuritApiKey(t *testing.T) {
 					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_api_key.test", "encoded"),
 				),
 			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.APIKeyUpdateMinVersion),
+				Config:   testAccResourceSecuritApiKeyUpdate(apiKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "name", apiKeyName),
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "role_descriptors", func(testValue string) error {
+						var testRoleDescriptor map[string]models.Role
+						if err := json.Unmarshal([]byte(testValue), &testRoleDescriptor); err != nil {
+							return err
+						}
+
+						allowRestrictedIndices := false
+						expectedRoleDescriptor := map[string]models.Role{
+							"role-a": {
+								Cluster: []string{"manage"},
+								Indices: []models.IndexPerms{{
+									Names:                  []string{"index-a*", "index-b*"},
+									Privileges:             []string{"read"},
+									AllowRestrictedIndices: &allowRestrictedIndices,
+								}},
+							},
+						}
+
+						if !reflect.DeepEqual(testRoleDescriptor, expectedRoleDescriptor) {
+							return fmt.Errorf("%v doesn't match %v", testRoleDescriptor, expectedRoleDescriptor)
+						}
+
+						return nil
+					}),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "metadata", `{"env":"test"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecurityCrossClusterApiKey(t *testing.T) {
+	apiKeyName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityApiKeyDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.CrossClusterAPIKeyMinVersion),
+				Config:   testAccResourceSecurityCrossClusterApiKey(apiKeyName, "logs-*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "name", apiKeyName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "type", "cross_cluster"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "access.0.search.0.names.0", "logs-*"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "access.0.replication.0.names.0", "archive-*"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_api_key.test", "encoded"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.CrossClusterAPIKeyMinVersion),
+				Config:   testAccResourceSecurityCrossClusterApiKey(apiKeyName, "metrics-*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "type", "cross_cluster"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "access.0.search.0.names.0", "metrics-*"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecurityApiKeyRotation(t *testing.T) {
+	apiKeyName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+	var firstKeyId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityApiKeyDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.APIKeyMinVersion),
+				Config:   testAccResourceSecurityApiKeyRotation(apiKeyName, "8760h", "1h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_api_key.test", "creation_timestamp"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "previous_key_id", ""),
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "id", func(value string) error {
+						firstKeyId = value
+						return nil
+					}),
+				),
+			},
+			{
+				// The key is past its rotation period as soon as it's created, so the plan following the apply isn't empty.
+				SkipFunc:           versionutils.CheckIfVersionIsUnsupported(security.APIKeyMinVersion),
+				PreConfig:          func() { time.Sleep(time.Second) },
+				Config:             testAccResourceSecurityApiKeyRotation(apiKeyName, "1s", "1h"),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "id", func(value string) error {
+						if value == firstKeyId {
+							return fmt.Errorf("expected the API key to be rotated")
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "previous_key_id", func(value string) error {
+						compId, _ := clients.CompositeIdFromStr(firstKeyId)
+						if value != compId.ResourceId {
+							return fmt.Errorf("expected previous_key_id to be %s, got %s", compId.ResourceId, value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_api_key.test", "previous_encoded"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_api_key.test", "encoded"),
+					func(*terraform.State) error {
+						time.Sleep(time.Second)
+						return nil
+					},
+				),
+			},
+			{
+				SkipFunc:  versionutils.CheckIfVersionIsUnsupported(security.APIKeyMinVersion),
+				PreConfig: func() { time.Sleep(time.Second) },
+				Config:    testAccResourceSecurityApiKeyRotation(apiKeyName, "8760h", "1s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "previous_key_id", ""),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "previous_encoded", ""),
+				),
+			},
 		},
 	})
 }
@@ -89,6 +215,80 @@ resource "elasticstack_elasticsearch_security_api_key" "test" {
 	`, apiKeyName)
 }
 
+func testAccResourceSecuritApiKeyUpdate(apiKeyName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_api_key" "test" {
+  name = "%s"
+
+  role_descriptors = jsonencode({
+    role-a = {
+      cluster = ["manage"]
+      indices = [{
+        names = ["index-a*", "index-b*"]
+        privileges = ["read"]
+        allow_restricted_indices = false
+      }]
+    }
+  })
+
+  metadata = jsonencode({
+    env = "test"
+  })
+
+	expiration = "1d"
+}
+	`, apiKeyName)
+}
+
+func testAccResourceSecurityCrossClusterApiKey(apiKeyName string, searchIndex string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_api_key" "test" {
+  name = "%s"
+  type = "cross_cluster"
+
+  access {
+    search {
+      names = ["%s"]
+    }
+    replication {
+      names = ["archive-*"]
+    }
+  }
+
+  expiration = "1d"
+}
+	`, apiKeyName, searchIndex)
+}
+
+func testAccResourceSecurityApiKeyRotation(apiKeyName string, rotationPeriod string, overlap string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_api_key" "test" {
+  name = "%s"
+
+  role_descriptors = jsonencode({
+    role-a = {
+      cluster = ["monitor"]
+    }
+  })
+
+  rotation_period = "%s"
+  overlap         = "%s"
+}
+	`, apiKeyName, rotationPeriod, overlap)
+}
+
 func checkResourceSecurityApiKeyDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
@@ -0,0 +1,271 @@
+package security
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceHasPrivileges() *schema.Resource {
+	hasPrivilegesSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"username": {
+			Description:   "The user to check the privileges of, using run as. Requires the `run_as` privilege for this user. Defaults to the authenticated user, or the owner of `api_key`, which is returned in this attribute.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"api_key"},
+		},
+		"api_key": {
+			Description:   "An encoded API key to check the privileges of, instead of the authenticated user.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ConflictsWith: []string{"username"},
+		},
+		"cluster": {
+			Description:  "A list of the cluster privileges to check.",
+			Type:         schema.TypeSet,
+			Optional:     true,
+			AtLeastOneOf: []string{"cluster", "index", "application"},
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"index": {
+			Description:  "The index privileges to check.",
+			Type:         schema.TypeList,
+			Optional:     true,
+			AtLeastOneOf: []string{"cluster", "index", "application"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"names": {
+						Description: "A list of indices.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"privileges": {
+						Description: "A list of the privileges to check for the specified indices.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"allow_restricted_indices": {
+						Description: "Whether to check the privileges on restricted indices when `names` contains patterns.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+		"application": {
+			Description:  "The application privileges to check.",
+			Type:         schema.TypeList,
+			Optional:     true,
+			AtLeastOneOf: []string{"cluster", "index", "application"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"application": {
+						Description: "The name of the application.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"privileges": {
+						Description: "A list of the application privileges or actions to check.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"resources": {
+						Description: "A list of resources to check the privileges for.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"has_all_requested": {
+			Description: "Whether all the requested privileges are granted.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"cluster_results": {
+			Description: "Whether each of the requested cluster privileges is granted.",
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeBool,
+			},
+		},
+		"index_results": {
+			Description: "Whether each of the requested index privileges is granted, per index.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the index.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"privileges": {
+						Description: "Whether each of the requested privileges is granted on the index.",
+						Type:        schema.TypeMap,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeBool,
+						},
+					},
+				},
+			},
+		},
+		"application_results": {
+			Description: "Whether each of the requested application privileges is granted, per application resource.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"application": {
+						Description: "The name of the application.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"resource": {
+						Description: "The name of the resource.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"privileges": {
+						Description: "Whether each of the requested privileges is granted on the resource.",
+						Type:        schema.TypeMap,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeBool,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(hasPrivilegesSchema)
+
+	return &schema.Resource{
+		Description: "Checks whether a user or an API key has the specified privileges. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-has-privileges.html",
+		ReadContext: dataSourceSecurityHasPrivilegesRead,
+		Schema:      hasPrivilegesSchema,
+	}
+}
+
+func dataSourceSecurityHasPrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	var request models.HasPrivilegesRequest
+	request.Cluster = utils.ExpandStringSet(d.Get("cluster").(*schema.Set))
+	for _, v := range d.Get("index").([]interface{}) {
+		index := v.(map[string]interface{})
+		allowRestrictedIndices := index["allow_restricted_indices"].(bool)
+		request.Index = append(request.Index, models.IndexPerms{
+			Names:                  utils.ExpandStringSet(index["names"].(*schema.Set)),
+			Privileges:             utils.ExpandStringSet(index["privileges"].(*schema.Set)),
+			AllowRestrictedIndices: &allowRestrictedIndices,
+		})
+	}
+	for _, v := range d.Get("application").([]interface{}) {
+		application := v.(map[string]interface{})
+		request.Application = append(request.Application, models.Application{
+			Name:       application["application"].(string),
+			Privileges: utils.ExpandStringSet(application["privileges"].(*schema.Set)),
+			Resources:  utils.ExpandStringSet(application["resources"].(*schema.Set)),
+		})
+	}
+
+	response, diags := elasticsearch.HasPrivileges(ctx, client, &request, privilegesRequestHeaders(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	id, diags := client.ID(ctx, response.Username)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if err := d.Set("username", response.Username); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("has_all_requested", response.HasAllRequested); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cluster_results", response.Cluster); err != nil {
+		return diag.FromErr(err)
+	}
+
+	indexResults := make([]interface{}, 0, len(response.Index))
+	for _, name := range utils.SortedKeys(response.Index) {
+		indexResults = append(indexResults, map[string]interface{}{
+			"name":       name,
+			"privileges": response.Index[name],
+		})
+	}
+	if err := d.Set("index_results", indexResults); err != nil {
+		return diag.FromErr(err)
+	}
+
+	applicationResults := make([]interface{}, 0)
+	for _, application := range utils.SortedKeys(response.Application) {
+		resources := response.Application[application]
+		for _, resource := range utils.SortedKeys(resources) {
+			applicationResults = append(applicationResults, map[string]interface{}{
+				"application": application,
+				"resource":    resource,
+				"privileges":  resources[resource],
+			})
+		}
+	}
+	if err := d.Set("application_results", applicationResults); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// privilegesRequestHeaders returns the headers to check the privileges of the configured user or API key,
+// rather than the ones of the authenticated user.
+func privilegesRequestHeaders(d *schema.ResourceData) map[string]string {
+	headers := make(map[string]string)
+	if v, ok := d.GetOk("username"); ok {
+		headers["es-security-runas-user"] = v.(string)
+	}
+	if v, ok := d.GetOk("api_key"); ok {
+		headers["Authorization"] = "ApiKey " + v.(string)
+	}
+	return headers
+}
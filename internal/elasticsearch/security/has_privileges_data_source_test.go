@@ -0,0 +1,80 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityHasPrivileges(t *testing.T) {
+	username := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityHasPrivileges(username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.granted", "username", username),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.granted", "has_all_requested", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.granted", "cluster_results.monitor", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.granted", "index_results.0.name", "logs-app"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.granted", "index_results.0.privileges.read", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.missing", "has_all_requested", "false"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.missing", "cluster_results.monitor", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.missing", "cluster_results.manage", "false"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.missing", "index_results.0.privileges.read", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.missing", "index_results.0.privileges.write", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecurityHasPrivileges(username string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role" "test" {
+  name    = "%[1]s"
+  cluster = ["monitor"]
+
+  indices {
+    names      = ["logs-*"]
+    privileges = ["read"]
+  }
+}
+
+resource "elasticstack_elasticsearch_security_user" "test" {
+  username = "%[1]s"
+  roles    = [elasticstack_elasticsearch_security_role.test.name]
+  password = "qwerty123"
+}
+
+data "elasticstack_elasticsearch_security_has_privileges" "granted" {
+  username = elasticstack_elasticsearch_security_user.test.username
+  cluster  = ["monitor"]
+
+  index {
+    names      = ["logs-app"]
+    privileges = ["read"]
+  }
+}
+
+data "elasticstack_elasticsearch_security_has_privileges" "missing" {
+  username = elasticstack_elasticsearch_security_user.test.username
+  cluster  = ["monitor", "manage"]
+
+  index {
+    names      = ["logs-app"]
+    privileges = ["read", "write"]
+  }
+}
+`, username)
+}
@@ -0,0 +1,81 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const defaultPasswordHashingAlgorithm = "bcrypt"
+
+// passwordHashingAlgorithms lists the algorithms of `xpack.security.authc.password_hashing.algorithm` which can be
+// produced client-side, see https://www.elastic.co/guide/en/elasticsearch/reference/current/security-settings.html#hashing-settings
+var passwordHashingAlgorithms = []string{
+	"bcrypt", "bcrypt4", "bcrypt5", "bcrypt6", "bcrypt7", "bcrypt8", "bcrypt9", "bcrypt10", "bcrypt11", "bcrypt12", "bcrypt13", "bcrypt14",
+	"pbkdf2", "pbkdf2_1000", "pbkdf2_10000", "pbkdf2_50000", "pbkdf2_100000", "pbkdf2_500000", "pbkdf2_1000000",
+}
+
+// bcryptMaxPasswordLength is the number of bytes of a password bcrypt can hash.
+const bcryptMaxPasswordLength = 72
+
+const (
+	pbkdf2Prefix      = "{PBKDF2}"
+	pbkdf2DefaultCost = 10000
+	pbkdf2SaltLength  = 32
+	pbkdf2KeyLength   = 32
+)
+
+// hashPassword hashes the password the same way Elasticsearch does with the given hashing algorithm.
+func hashPassword(password, algorithm string) (string, error) {
+	switch {
+	case algorithm == "bcrypt":
+		return hashPasswordBcrypt(password, bcrypt.DefaultCost)
+	case strings.HasPrefix(algorithm, "bcrypt"):
+		cost, err := strconv.Atoi(strings.TrimPrefix(algorithm, "bcrypt"))
+		if err != nil {
+			return "", fmt.Errorf("unsupported password hashing algorithm: %s", algorithm)
+		}
+		return hashPasswordBcrypt(password, cost)
+	case algorithm == "pbkdf2":
+		return hashPasswordPbkdf2(password, pbkdf2DefaultCost)
+	case strings.HasPrefix(algorithm, "pbkdf2_"):
+		cost, err := strconv.Atoi(strings.TrimPrefix(algorithm, "pbkdf2_"))
+		if err != nil {
+			return "", fmt.Errorf("unsupported password hashing algorithm: %s", algorithm)
+		}
+		return hashPasswordPbkdf2(password, cost)
+	}
+	return "", fmt.Errorf("unsupported password hashing algorithm: %s", algorithm)
+}
+
+// validatePasswordForAlgorithm checks the password can be hashed with the given hashing algorithm.
+func validatePasswordForAlgorithm(password, algorithm string) error {
+	if strings.HasPrefix(algorithm, "bcrypt") && len(password) > bcryptMaxPasswordLength {
+		return fmt.Errorf("passwords hashed with %s can't be longer than %d bytes, got %d bytes", algorithm, bcryptMaxPasswordLength, len(password))
+	}
+	return nil
+}
+
+func hashPasswordBcrypt(password string, cost int) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// hashPasswordPbkdf2 produces hashes in the `{PBKDF2}<cost>$<salt>$<hash>` format, using HMAC-SHA512.
+func hashPasswordPbkdf2(password string, cost int) (string, error) {
+	salt := make([]byte, pbkdf2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := pbkdf2.Key([]byte(password), salt, cost, pbkdf2KeyLength, sha512.New)
+	return fmt.Sprintf("%s%d$%s$%s", pbkdf2Prefix, cost, base64.StdEncoding.EncodeToString(salt), base64.StdEncoding.EncodeToString(key)), nil
+}
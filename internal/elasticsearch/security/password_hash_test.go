@@ -0,0 +1,76 @@
+package security
+
+import (
+	"crypto/sha512"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+func TestHashPasswordBcrypt(t *testing.T) {
+	for algorithm, expectedCost := range map[string]int{"bcrypt": 10, "bcrypt4": 4} {
+		hash, err := hashPassword("changeme", algorithm)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(hash, "$2a$"), hash)
+
+		cost, err := bcrypt.Cost([]byte(hash))
+		require.NoError(t, err)
+		require.Equal(t, expectedCost, cost)
+		require.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("changeme")))
+	}
+}
+
+func TestHashPasswordPbkdf2(t *testing.T) {
+	for algorithm, expectedCost := range map[string]int{"pbkdf2": 10000, "pbkdf2_1000": 1000} {
+		hash, err := hashPassword("changeme", algorithm)
+		require.NoError(t, err)
+
+		parts := strings.Split(strings.TrimPrefix(hash, "{PBKDF2}"), "$")
+		require.Len(t, parts, 3, hash)
+		require.Equal(t, strconv.Itoa(expectedCost), parts[0])
+
+		salt, err := base64.StdEncoding.DecodeString(parts[1])
+		require.NoError(t, err)
+		key := pbkdf2.Key([]byte("changeme"), salt, expectedCost, 32, sha512.New)
+		require.Equal(t, base64.StdEncoding.EncodeToString(key), parts[2])
+	}
+}
+
+func TestHashPasswordUnsupportedAlgorithm(t *testing.T) {
+	for _, algorithm := range []string{"ssha256", "bcryptx", "pbkdf2_stretch"} {
+		_, err := hashPassword("changeme", algorithm)
+		require.Error(t, err, algorithm)
+	}
+}
+
+func TestHashPasswordAlgorithms(t *testing.T) {
+	for _, algorithm := range passwordHashingAlgorithms {
+		if strings.HasSuffix(algorithm, "_500000") || strings.HasSuffix(algorithm, "_1000000") || strings.HasSuffix(algorithm, "14") || strings.HasSuffix(algorithm, "13") {
+			// Skip the slowest variants.
+			continue
+		}
+		_, err := hashPassword("changeme", algorithm)
+		require.NoError(t, err, algorithm)
+	}
+}
+
+func TestValidatePasswordForAlgorithm(t *testing.T) {
+	maxLength := strings.Repeat("a", 72)
+	tooLong := strings.Repeat("a", 73)
+
+	for _, algorithm := range []string{"bcrypt", "bcrypt4"} {
+		require.NoError(t, validatePasswordForAlgorithm(maxLength, algorithm), algorithm)
+		require.ErrorContains(t, validatePasswordForAlgorithm(tooLong, algorithm), "can't be longer than 72 bytes", algorithm)
+
+		_, err := hashPassword(maxLength, algorithm)
+		require.NoError(t, err, algorithm)
+	}
+	// multi-byte characters count as several bytes
+	require.Error(t, validatePasswordForAlgorithm(strings.Repeat("é", 37), "bcrypt"))
+	require.NoError(t, validatePasswordForAlgorithm(strings.Repeat("a", 128), "pbkdf2"))
+}
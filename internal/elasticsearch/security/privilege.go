@@ -0,0 +1,194 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourcePrivilege() *schema.Resource {
+	privilegeSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"application": {
+			Description: "The name of the application to which the privilege belongs.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(3, 1024),
+				validation.StringMatch(regexp.MustCompile(`^[a-z][a-zA-Z0-9_-]*$`), "must begin with a lowercase ASCII letter, and contain only ASCII letters, digits, `_` and `-`"),
+			),
+		},
+		"name": {
+			Description: "The name of the privilege.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 1024),
+				validation.StringMatch(regexp.MustCompile(`^[a-z][a-zA-Z0-9_.-]*$`), "must begin with a lowercase ASCII letter, and contain only ASCII letters, digits, `_`, `-` and `.`"),
+			),
+		},
+		"actions": {
+			Description: "A list of the actions granted by the privilege. Actions must contain one of `/`, `*` or `:`.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"metadata": {
+			Description:      "Optional meta-data.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+	}
+
+	utils.AddConnectionSchema(privilegeSchema)
+
+	return &schema.Resource{
+		Description: "Adds and updates application privileges. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-put-privileges.html",
+
+		CreateContext: resourceSecurityPrivilegePut,
+		UpdateContext: resourceSecurityPrivilegePut,
+		ReadContext:   resourceSecurityPrivilegeRead,
+		DeleteContext: resourceSecurityPrivilegeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: privilegeSchema,
+	}
+}
+
+func resourceSecurityPrivilegePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	privilege := models.ApplicationPrivilege{
+		Application: d.Get("application").(string),
+		Name:        d.Get("name").(string),
+		Actions:     utils.ExpandStringSet(d.Get("actions").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		metadata := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&metadata); err != nil {
+			return diag.FromErr(err)
+		}
+		privilege.Metadata = metadata
+	}
+
+	id, diags := client.ID(ctx, privilegeResourceId(privilege.Application, privilege.Name))
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.PutPrivilege(ctx, client, &privilege); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	return resourceSecurityPrivilegeRead(ctx, d, meta)
+}
+
+func resourceSecurityPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	application, name, diags := privilegeFromResourceId(compId.ResourceId)
+	if diags.HasError() {
+		return diags
+	}
+
+	privilege, diags := elasticsearch.GetPrivilege(ctx, client, application, name)
+	if privilege == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Application privilege "%s" not found, removing from state`, compId.ResourceId))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("application", privilege.Application); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", privilege.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("actions", privilege.Actions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata, err := json.Marshal(privilege.Metadata)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata", string(metadata)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceSecurityPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	application, name, diags := privilegeFromResourceId(compId.ResourceId)
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.DeletePrivilege(ctx, client, application, name); diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
+// The application and privilege names cannot contain `:`, it's used to join them in the resource ID.
+func privilegeResourceId(application, name string) string {
+	return fmt.Sprintf("%s:%s", application, name)
+}
+
+func privilegeFromResourceId(resourceId string) (string, string, diag.Diagnostics) {
+	application, name, ok := strings.Cut(resourceId, ":")
+	if !ok {
+		return "", "", diag.Errorf("Application privilege ID must have following format: <cluster_uuid>/<application>:<privilege name>")
+	}
+	return application, name, nil
+}
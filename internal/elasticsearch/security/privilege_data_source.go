@@ -0,0 +1,75 @@
+package security
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourcePrivilege() *schema.Resource {
+	privilegeSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"application": {
+			Description: "The name of the application to which the privilege belongs.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: "The name of the privilege.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"actions": {
+			Description: "A list of the actions granted by the privilege.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"metadata": {
+			Description: "Optional meta-data.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(privilegeSchema)
+
+	return &schema.Resource{
+		Description: "Retrieves an application privilege. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-get-privileges.html",
+		ReadContext: dataSourceSecurityPrivilegeRead,
+		Schema:      privilegeSchema,
+	}
+}
+
+func dataSourceSecurityPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	application := d.Get("application").(string)
+	name := d.Get("name").(string)
+	id, diags := client.ID(ctx, privilegeResourceId(application, name))
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if diags := resourceSecurityPrivilegeRead(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+	if d.Id() == "" {
+		return diag.Errorf(`Application privilege "%s" not found`, privilegeResourceId(application, name))
+	}
+
+	return nil
+}
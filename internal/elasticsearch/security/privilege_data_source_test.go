@@ -0,0 +1,54 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityPrivilege(t *testing.T) {
+	application := "app-" + sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityPrivilege(application),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_privilege.test", "application", application),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_privilege.test", "name", "admin"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_privilege.test", "actions.*", "data:write/*"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_privilege.test", "actions.*", "action:admin"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_privilege.test", "metadata", `{"version":1}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecurityPrivilege(application string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_privilege" "test" {
+  application = "%s"
+  name        = "admin"
+  actions     = ["data:write/*", "action:admin"]
+
+  metadata = jsonencode({
+    version = 1
+  })
+}
+
+data "elasticstack_elasticsearch_security_privilege" "test" {
+  application = elasticstack_elasticsearch_security_privilege.test.application
+  name        = elasticstack_elasticsearch_security_privilege.test.name
+}
+	`, application)
+}
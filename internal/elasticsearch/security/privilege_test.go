@@ -0,0 +1,108 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSecurityPrivilege(t *testing.T) {
+	application := "app-" + sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityPrivilegeDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityPrivilegeCreate(application),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_privilege.test", "application", application),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_privilege.test", "name", "read"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_privilege.test", "actions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_privilege.test", "actions.*", "data:read/*"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_privilege.test", "metadata", "{}"),
+				),
+			},
+			{
+				Config: testAccResourceSecurityPrivilegeUpdate(application),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_privilege.test", "actions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_privilege.test", "actions.*", "data:read/*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_privilege.test", "actions.*", "action:login"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_privilege.test", "metadata", `{"description":"Read access"}`),
+				),
+			},
+			{
+				ResourceName:      "elasticstack_elasticsearch_security_privilege.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityPrivilegeCreate(application string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_privilege" "test" {
+  application = "%s"
+  name        = "read"
+  actions     = ["data:read/*"]
+}
+	`, application)
+}
+
+func testAccResourceSecurityPrivilegeUpdate(application string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_privilege" "test" {
+  application = "%s"
+  name        = "read"
+  actions     = ["data:read/*", "action:login"]
+
+  metadata = jsonencode({
+    description = "Read access"
+  })
+}
+	`, application)
+}
+
+func checkResourceSecurityPrivilegeDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_security_privilege" {
+			continue
+		}
+
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+		application, name, _ := strings.Cut(compId.ResourceId, ":")
+		privilege, diags := elasticsearch.GetPrivilege(context.Background(), client, application, name)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get application privilege: %v", diags)
+		}
+
+		if privilege != nil {
+			return fmt.Errorf("Application privilege (%s) still exists", compId.ResourceId)
+		}
+	}
+	return nil
+}
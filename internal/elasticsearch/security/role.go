@@ -10,12 +10,19 @@ import (
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var (
+	RoleRemoteIndicesMinVersion = version.Must(version.NewVersion("8.10.0"))
+	RoleRemoteClusterMinVersion = version.Must(version.NewVersion("8.15.0"))
+	RoleDescriptionMinVersion   = version.Must(version.NewVersion("8.15.0"))
+)
+
 func ResourceRole() *schema.Resource {
 	roleSchema := map[string]*schema.Schema{
 		"id": {
@@ -29,6 +36,11 @@ func ResourceRole() *schema.Resource {
 			Required:    true,
 			ForceNew:    true,
 		},
+		"description": {
+			Description: "The description of the role. Requires Elasticsearch 8.15 or later.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
 		"applications": {
 			Description: "A list of application privilege entries.",
 			Type:        schema.TypeSet,
@@ -137,6 +149,102 @@ func ResourceRole() *schema.Resource {
 				},
 			},
 		},
+		"remote_indices": {
+			Description: "A list of remote indices permissions entries, used for remote clusters connected with the API key based security model. Requires Elasticsearch 8.10 or later.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"clusters": {
+						Description: "A list of remote cluster aliases (or cluster alias patterns) to which the permissions in this entry apply.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"field_security": {
+						Description: "The document fields that the owners of the role have read access to.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"grant": {
+									Description: "List of the fields to grant the access to.",
+									Type:        schema.TypeSet,
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"except": {
+									Description: "List of the fields to which the grants will not be applied.",
+									Type:        schema.TypeSet,
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+					"names": {
+						Description: "A list of indices (or index name patterns) to which the permissions in this entry apply.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"privileges": {
+						Description: "The index level privileges that the owners of the role have on the specified indices.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"query": {
+						Description:      "A search query that defines the documents the owners of the role have read access to.",
+						Type:             schema.TypeString,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: utils.DiffJsonSuppress,
+						Optional:         true,
+					},
+					"allow_restricted_indices": {
+						Description: "Include matching restricted indices in names parameter. Usage is strongly discouraged as it can grant unrestricted operations on critical data, make the entire system unstable or leak sensitive information.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"remote_cluster": {
+			Description: "A list of remote cluster permissions entries, used for remote clusters connected with the API key based security model. Requires Elasticsearch 8.15 or later.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"clusters": {
+						Description: "A list of remote cluster aliases (or cluster alias patterns) to which the permissions in this entry apply.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"privileges": {
+						Description: "The cluster level privileges that the owners of the role have on the remote clusters, e.g. `monitor_enrich`.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
 		"metadata": {
 			Description:      "Optional meta-data.",
 			Type:             schema.TypeString,
@@ -183,8 +291,21 @@ func resourceSecurityRolePut(ctx context.Context, d *schema.ResourceData, meta i
 	if diags.HasError() {
 		return diags
 	}
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
 	var role models.Role
 	role.Name = roleId
+
+	if v, ok := d.GetOk("description"); ok {
+		if serverVersion.LessThan(RoleDescriptionMinVersion) {
+			return diag.Errorf("'description' is supported only for Elasticsearch v%s and above", RoleDescriptionMinVersion.String())
+		}
+		description := v.(string)
+		role.Description = &description
+	}
 	if v, ok := d.GetOk("applications"); ok {
 		definedApps := v.(*schema.Set)
 		applications := make([]models.Application, definedApps.Len())
@@ -233,57 +354,41 @@ func resourceSecurityRolePut(ctx context.Context, d *schema.ResourceData, meta i
 		definedIndices := v.(*schema.Set)
 		indices := make([]models.IndexPerms, definedIndices.Len())
 		for i, idx := range definedIndices.List() {
-			index := idx.(map[string]interface{})
-
-			definedNames := index["names"].(*schema.Set)
-			names := make([]string, definedNames.Len())
-			for i, name := range definedNames.List() {
-				names[i] = name.(string)
-			}
-			definedPrivs := index["privileges"].(*schema.Set)
-			privs := make([]string, definedPrivs.Len())
-			for i, pr := range definedPrivs.List() {
-				privs[i] = pr.(string)
-			}
+			indices[i] = expandIndexPerms(idx.(map[string]interface{}))
+		}
+		role.Indices = indices
+	}
 
-			newIndex := models.IndexPerms{
-				Names:      names,
-				Privileges: privs,
+	if v, ok := d.GetOk("remote_indices"); ok {
+		if serverVersion.LessThan(RoleRemoteIndicesMinVersion) {
+			return diag.Errorf("'remote_indices' is supported only for Elasticsearch v%s and above", RoleRemoteIndicesMinVersion.String())
+		}
+		definedIndices := v.(*schema.Set)
+		remoteIndices := make([]models.RemoteIndexPerms, definedIndices.Len())
+		for i, idx := range definedIndices.List() {
+			index := idx.(map[string]interface{})
+			remoteIndices[i] = models.RemoteIndexPerms{
+				IndexPerms: expandIndexPerms(index),
+				Clusters:   utils.ExpandStringSet(index["clusters"].(*schema.Set)),
 			}
+		}
+		role.RemoteIndices = remoteIndices
+	}
 
-			if query := index["query"].(string); query != "" {
-				newIndex.Query = &query
-			}
-			if fieldSec := index["field_security"].([]interface{}); len(fieldSec) > 0 {
-				fieldSecurity := models.FieldSecurity{}
-				// there must be only 1 entry
-				definedFieldSec := fieldSec[0].(map[string]interface{})
-
-				// grants
-				if gr := definedFieldSec["grant"].(*schema.Set); gr != nil {
-					grants := make([]string, gr.Len())
-					for i, grant := range gr.List() {
-						grants[i] = grant.(string)
-					}
-					fieldSecurity.Grant = grants
-				}
-				// except
-				if exp := definedFieldSec["except"].(*schema.Set); exp != nil {
-					excepts := make([]string, exp.Len())
-					for i, except := range exp.List() {
-						excepts[i] = except.(string)
-					}
-					fieldSecurity.Except = excepts
-				}
-				newIndex.FieldSecurity = &fieldSecurity
+	if v, ok := d.GetOk("remote_cluster"); ok {
+		if serverVersion.LessThan(RoleRemoteClusterMinVersion) {
+			return diag.Errorf("'remote_cluster' is supported only for Elasticsearch v%s and above", RoleRemoteClusterMinVersion.String())
+		}
+		definedClusters := v.(*schema.Set)
+		remoteClusters := make([]models.RemoteClusterPerms, definedClusters.Len())
+		for i, cl := range definedClusters.List() {
+			remoteCluster := cl.(map[string]interface{})
+			remoteClusters[i] = models.RemoteClusterPerms{
+				Clusters:   utils.ExpandStringSet(remoteCluster["clusters"].(*schema.Set)),
+				Privileges: utils.ExpandStringSet(remoteCluster["privileges"].(*schema.Set)),
 			}
-
-			allowRestrictedIndices := index["allow_restricted_indices"].(bool)
-			newIndex.AllowRestrictedIndices = &allowRestrictedIndices
-
-			indices[i] = newIndex
 		}
-		role.Indices = indices
+		role.RemoteCluster = remoteClusters
 	}
 
 	if v, ok := d.GetOk("metadata"); ok {
@@ -337,6 +442,10 @@ func resourceSecurityRoleRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("description", role.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
 	apps := role.Applications
 	applications := flattenApplicationsData(&apps)
 	if err := d.Set("applications", applications); err != nil {
@@ -363,6 +472,14 @@ func resourceSecurityRoleRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("remote_indices", flattenRemoteIndicesData(role.RemoteIndices)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("remote_cluster", flattenRemoteClusterData(role.RemoteCluster)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if role.Metadata != nil {
 		metadata, err := json.Marshal(role.Metadata)
 		if err != nil {
@@ -419,6 +536,78 @@ func flattenIndicesData(indices *[]models.IndexPerms) []interface{} {
 	return make([]interface{}, 0)
 }
 
+func flattenRemoteIndicesData(remoteIndices []models.RemoteIndexPerms) []interface{} {
+	oindx := make([]interface{}, len(remoteIndices))
+	for i, remoteIndex := range remoteIndices {
+		indices := []models.IndexPerms{remoteIndex.IndexPerms}
+		oi := flattenIndicesData(&indices)[0].(map[string]interface{})
+		oi["clusters"] = remoteIndex.Clusters
+		oindx[i] = oi
+	}
+	return oindx
+}
+
+func flattenRemoteClusterData(remoteClusters []models.RemoteClusterPerms) []interface{} {
+	ocls := make([]interface{}, len(remoteClusters))
+	for i, remoteCluster := range remoteClusters {
+		ocls[i] = map[string]interface{}{
+			"clusters":   remoteCluster.Clusters,
+			"privileges": remoteCluster.Privileges,
+		}
+	}
+	return ocls
+}
+
+func expandIndexPerms(index map[string]interface{}) models.IndexPerms {
+	definedNames := index["names"].(*schema.Set)
+	names := make([]string, definedNames.Len())
+	for i, name := range definedNames.List() {
+		names[i] = name.(string)
+	}
+	definedPrivs := index["privileges"].(*schema.Set)
+	privs := make([]string, definedPrivs.Len())
+	for i, pr := range definedPrivs.List() {
+		privs[i] = pr.(string)
+	}
+
+	newIndex := models.IndexPerms{
+		Names:      names,
+		Privileges: privs,
+	}
+
+	if query := index["query"].(string); query != "" {
+		newIndex.Query = &query
+	}
+	if fieldSec := index["field_security"].([]interface{}); len(fieldSec) > 0 {
+		fieldSecurity := models.FieldSecurity{}
+		// there must be only 1 entry
+		definedFieldSec := fieldSec[0].(map[string]interface{})
+
+		// grants
+		if gr := definedFieldSec["grant"].(*schema.Set); gr != nil {
+			grants := make([]string, gr.Len())
+			for i, grant := range gr.List() {
+				grants[i] = grant.(string)
+			}
+			fieldSecurity.Grant = grants
+		}
+		// except
+		if exp := definedFieldSec["except"].(*schema.Set); exp != nil {
+			excepts := make([]string, exp.Len())
+			for i, except := range exp.List() {
+				excepts[i] = except.(string)
+			}
+			fieldSecurity.Except = excepts
+		}
+		newIndex.FieldSecurity = &fieldSecurity
+	}
+
+	allowRestrictedIndices := index["allow_restricted_indices"].(bool)
+	newIndex.AllowRestrictedIndices = &allowRestrictedIndices
+
+	return newIndex
+}
+
 func resourceSecurityRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClientFromSDKResource(d, meta)
 	if diags.HasError() {
@@ -21,6 +21,11 @@ func DataSourceRole() *schema.Resource {
 			Type:        schema.TypeString,
 			Required:    true,
 		},
+		"description": {
+			Description: "The description of the role.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 		"applications": {
 			Description: "A list of application privilege entries.",
 			Type:        schema.TypeSet,
@@ -124,6 +129,99 @@ func DataSourceRole() *schema.Resource {
 				},
 			},
 		},
+		"remote_indices": {
+			Description: "A list of remote indices permissions entries.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"clusters": {
+						Description: "A list of remote cluster aliases (or cluster alias patterns) to which the permissions in this entry apply.",
+						Type:        schema.TypeSet,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"field_security": {
+						Description: "The document fields that the owners of the role have read access to.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"grant": {
+									Description: "List of the fields to grant the access to.",
+									Type:        schema.TypeSet,
+									Computed:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"except": {
+									Description: "List of the fields to which the grants will not be applied.",
+									Type:        schema.TypeSet,
+									Computed:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+					"names": {
+						Description: "A list of indices (or index name patterns) to which the permissions in this entry apply.",
+						Type:        schema.TypeSet,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"privileges": {
+						Description: "The index level privileges that the owners of the role have on the specified indices.",
+						Type:        schema.TypeSet,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"query": {
+						Description: "A search query that defines the documents the owners of the role have read access to.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"allow_restricted_indices": {
+						Description: "Include matching restricted indices in names parameter. Usage is strongly discouraged as it can grant unrestricted operations on critical data, make the entire system unstable or leak sensitive information.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"remote_cluster": {
+			Description: "A list of remote cluster permissions entries.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"clusters": {
+						Description: "A list of remote cluster aliases (or cluster alias patterns) to which the permissions in this entry apply.",
+						Type:        schema.TypeSet,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"privileges": {
+						Description: "The cluster level privileges that the owners of the role have on the remote clusters.",
+						Type:        schema.TypeSet,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
 		"metadata": {
 			Description: "Optional meta-data.",
 			Type:        schema.TypeString,
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceRoleMapping() *schema.Resource {
@@ -35,9 +36,20 @@ func ResourceRoleMapping() *schema.Resource {
 		},
 		"rules": {
 			Type:             schema.TypeString,
-			Required:         true,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: utils.DiffJsonSuppress,
-			Description:      "The rules that determine which users should be matched by the mapping. A rule is a logical condition that is expressed by using a JSON DSL.",
+			ExactlyOneOf:     []string{"rules", "rule"},
+			Description:      "The rules that determine which users should be matched by the mapping. A rule is a logical condition that is expressed by using a JSON DSL. Computed from `rule` when the rules are configured as a block.",
+		},
+		"rule": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"rules", "rule"},
+			Description:  "The rules that determine which users should be matched by the mapping, as a typed alternative to `rules`. Each rule sets exactly one of `field`, `any`, `all` or `except`.",
+			Elem:         roleMappingRuleSchema(roleMappingRuleMaxDepth),
 		},
 		"roles": {
 			Type: schema.TypeSet,
@@ -76,6 +88,8 @@ func ResourceRoleMapping() *schema.Resource {
 		ReadContext:   resourceSecurityRoleMappingRead,
 		DeleteContext: resourceSecurityRoleMappingDelete,
 
+		CustomizeDiff: resourceSecurityRoleMappingCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -95,8 +109,8 @@ func resourceSecurityRoleMappingPut(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	var rules map[string]interface{}
-	if err := json.Unmarshal([]byte(d.Get("rules").(string)), &rules); err != nil {
+	rules, err := roleMappingRulesFromConfig(d)
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -123,6 +137,14 @@ func resourceSecurityRoleMappingPut(ctx context.Context, d *schema.ResourceData,
 	return resourceSecurityRoleMappingRead(ctx, d, meta)
 }
 
+func resourceSecurityRoleMappingCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The JSON rules are derived from the rule block, so they're only known once the mapping is applied.
+	if v, ok := d.GetOk("rule"); ok && len(v.([]interface{})) > 0 && d.HasChange("rule") {
+		return d.SetNewComputed("rules")
+	}
+	return nil
+}
+
 func resourceSecurityRoleMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClientFromSDKResource(d, meta)
 	if diags.HasError() {
@@ -176,6 +198,16 @@ func resourceSecurityRoleMappingRead(ctx context.Context, d *schema.ResourceData
 	if err := d.Set("rules", string(rules)); err != nil {
 		return diag.FromErr(err)
 	}
+	// The typed rules are only tracked when they're used in the configuration, imported mappings keep the JSON rules.
+	if v, ok := d.GetOk("rule"); ok && len(v.([]interface{})) > 0 {
+		rule, err := flattenRoleMappingRule(roleMapping.Rules, roleMappingRuleMaxDepth)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("rule", rule); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	if err := d.Set("metadata", string(metadata)); err != nil {
 		return diag.FromErr(err)
 	}
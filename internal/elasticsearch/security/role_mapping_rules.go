@@ -0,0 +1,480 @@
+package security
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// roleMappingRuleMaxDepth is the number of nested `any`, `all` and `except` levels supported by the `rule` block.
+// Terraform schemas can't be recursive, so the block is unrolled up to this depth.
+const roleMappingRuleMaxDepth = 5
+
+func roleMappingRuleSchema(depth int) *schema.Resource {
+	ruleSchema := map[string]*schema.Schema{
+		"field": {
+			Description: "Matches when the named user field has any of the given values.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The user field to test: `username`, `dn`, `groups`, `realm.name` or `metadata.<key>`.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"values": {
+						Description: "The string values to match. Values support `*` and `?` wildcards, and regular expressions enclosed in `/`.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"number_values": {
+						Description: "The numeric values to match, usually against `metadata.<key>` fields.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeFloat,
+						},
+					},
+					"bool_values": {
+						Description: "The boolean values to match, usually against `metadata.<key>` fields.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeBool,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if depth > 0 {
+		child := roleMappingRuleSchema(depth - 1)
+		ruleSchema["any"] = &schema.Schema{
+			Description: "Matches when any of the nested rules match.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rule": {
+						Description: "The nested rules.",
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						Elem:        child,
+					},
+				},
+			},
+		}
+		ruleSchema["all"] = &schema.Schema{
+			Description: "Matches when all of the nested rules match.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rule": {
+						Description: "The nested rules.",
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						Elem:        child,
+					},
+				},
+			},
+		}
+		ruleSchema["except"] = &schema.Schema{
+			Description: "Matches when the nested rule doesn't match.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        child,
+		}
+	}
+
+	return &schema.Resource{Schema: ruleSchema}
+}
+
+// expandRoleMappingRule converts a `rule` block into the JSON DSL accepted by Elasticsearch.
+func expandRoleMappingRule(rule map[string]interface{}) (map[string]interface{}, error) {
+	if rule == nil {
+		return nil, fmt.Errorf("rules must not be empty")
+	}
+	expanded := make(map[string]interface{})
+
+	if v, ok := rule["field"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		field := v[0].(map[string]interface{})
+		name := field["name"].(string)
+
+		// The values keep their JSON type, so that numbers and booleans match the user metadata
+		var values []interface{}
+		for _, key := range []string{"values", "number_values", "bool_values"} {
+			if typedValues, ok := field[key].([]interface{}); ok {
+				values = append(values, typedValues...)
+			}
+		}
+		switch len(values) {
+		case 0:
+			return nil, fmt.Errorf("the rule for field `%s` must set `values`, `number_values` or `bool_values`", name)
+		case 1:
+			expanded["field"] = map[string]interface{}{name: values[0]}
+		default:
+			expanded["field"] = map[string]interface{}{name: values}
+		}
+	}
+	for _, op := range []string{"any", "all"} {
+		if v, ok := rule[op].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			nested := v[0].(map[string]interface{})["rule"].([]interface{})
+			rules := make([]interface{}, len(nested))
+			for i, r := range nested {
+				nestedRule, _ := r.(map[string]interface{})
+				expandedRule, err := expandRoleMappingRule(nestedRule)
+				if err != nil {
+					return nil, err
+				}
+				rules[i] = expandedRule
+			}
+			expanded[op] = rules
+		}
+	}
+	if v, ok := rule["except"].([]interface{}); ok && len(v) > 0 {
+		nestedRule, _ := v[0].(map[string]interface{})
+		expandedRule, err := expandRoleMappingRule(nestedRule)
+		if err != nil {
+			return nil, err
+		}
+		expanded["except"] = expandedRule
+	}
+
+	if len(expanded) != 1 {
+		return nil, fmt.Errorf("each rule must set exactly one of `field`, `any`, `all` or `except`")
+	}
+	return expanded, nil
+}
+
+// flattenRoleMappingRule converts rules returned by Elasticsearch into a `rule` block.
+func flattenRoleMappingRule(rule map[string]interface{}, depth int) ([]interface{}, error) {
+	if len(rule) != 1 {
+		return nil, fmt.Errorf("each rule must contain exactly one of `field`, `any`, `all` or `except`")
+	}
+
+	flattened := make(map[string]interface{})
+	for op, value := range rule {
+		if op != "field" && depth == 0 {
+			return nil, fmt.Errorf("rules are nested deeper than the %d levels supported by the `rule` block, use `rules` instead", roleMappingRuleMaxDepth)
+		}
+
+		switch op {
+		case "field":
+			field, ok := value.(map[string]interface{})
+			if !ok || len(field) != 1 {
+				return nil, fmt.Errorf("`field` must contain exactly one field name")
+			}
+			for name, v := range field {
+				var values []interface{}
+				if list, ok := v.([]interface{}); ok {
+					values = list
+				} else {
+					values = []interface{}{v}
+				}
+
+				stringValues := make([]interface{}, 0)
+				numberValues := make([]interface{}, 0)
+				boolValues := make([]interface{}, 0)
+				for _, fv := range values {
+					switch typed := fv.(type) {
+					case string:
+						stringValues = append(stringValues, typed)
+					case float64:
+						numberValues = append(numberValues, typed)
+					case bool:
+						boolValues = append(boolValues, typed)
+					default:
+						return nil, fmt.Errorf("the value `%v` of field `%s` can't be represented by the `rule` block, use `rules` instead", fv, name)
+					}
+				}
+				flattened["field"] = []interface{}{map[string]interface{}{
+					"name":          name,
+					"values":        stringValues,
+					"number_values": numberValues,
+					"bool_values":   boolValues,
+				}}
+			}
+		case "any", "all":
+			nested, ok := value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("`%s` must contain a list of rules", op)
+			}
+			rules := make([]interface{}, len(nested))
+			for i, r := range nested {
+				nestedRule, ok := r.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("`%s` must contain a list of rules", op)
+				}
+				flattenedRule, err := flattenRoleMappingRule(nestedRule, depth-1)
+				if err != nil {
+					return nil, err
+				}
+				rules[i] = flattenedRule[0]
+			}
+			flattened[op] = []interface{}{map[string]interface{}{"rule": rules}}
+		case "except":
+			nestedRule, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("`except` must contain a rule")
+			}
+			flattenedRule, err := flattenRoleMappingRule(nestedRule, depth-1)
+			if err != nil {
+				return nil, err
+			}
+			flattened["except"] = flattenedRule
+		default:
+			return nil, fmt.Errorf("unknown rule type `%s`", op)
+		}
+	}
+	return []interface{}{flattened}, nil
+}
+
+// roleMappingUser is the user data role mapping rules are evaluated against.
+type roleMappingUser struct {
+	Username string
+	Dn       string
+	Groups   []string
+	Realm    string
+	Metadata map[string]interface{}
+}
+
+func (u *roleMappingUser) fieldValues(field string) []interface{} {
+	optional := func(s string) []interface{} {
+		if s == "" {
+			return nil
+		}
+		return []interface{}{s}
+	}
+
+	switch field {
+	case "username":
+		return optional(u.Username)
+	case "dn":
+		return optional(u.Dn)
+	case "realm.name":
+		return optional(u.Realm)
+	case "groups":
+		values := make([]interface{}, len(u.Groups))
+		for i, g := range u.Groups {
+			values[i] = g
+		}
+		return values
+	}
+
+	if key, ok := strings.CutPrefix(field, "metadata."); ok {
+		switch v := u.Metadata[key].(type) {
+		case nil:
+			return nil
+		case []interface{}:
+			return v
+		default:
+			return []interface{}{v}
+		}
+	}
+	return nil
+}
+
+// evaluateRoleMappingRule mirrors how Elasticsearch evaluates role mapping rules against a user.
+func evaluateRoleMappingRule(rule map[string]interface{}, user *roleMappingUser) (bool, error) {
+	if len(rule) != 1 {
+		return false, fmt.Errorf("each rule must contain exactly one of `field`, `any`, `all` or `except`")
+	}
+
+	for op, value := range rule {
+		switch op {
+		case "field":
+			field, ok := value.(map[string]interface{})
+			if !ok || len(field) != 1 {
+				return false, fmt.Errorf("`field` must contain exactly one field name")
+			}
+			for name, expected := range field {
+				return matchRoleMappingField(name, expected, user)
+			}
+		case "any", "all":
+			nested, ok := value.([]interface{})
+			if !ok {
+				return false, fmt.Errorf("`%s` must contain a list of rules", op)
+			}
+			for _, r := range nested {
+				nestedRule, ok := r.(map[string]interface{})
+				if !ok {
+					return false, fmt.Errorf("`%s` must contain a list of rules", op)
+				}
+				match, err := evaluateRoleMappingRule(nestedRule, user)
+				if err != nil {
+					return false, err
+				}
+				if op == "any" && match {
+					return true, nil
+				}
+				if op == "all" && !match {
+					return false, nil
+				}
+			}
+			return op == "all", nil
+		case "except":
+			nestedRule, ok := value.(map[string]interface{})
+			if !ok {
+				return false, fmt.Errorf("`except` must contain a rule")
+			}
+			match, err := evaluateRoleMappingRule(nestedRule, user)
+			if err != nil {
+				return false, err
+			}
+			return !match, nil
+		default:
+			return false, fmt.Errorf("unknown rule type `%s`", op)
+		}
+	}
+	return false, nil
+}
+
+func matchRoleMappingField(name string, expected interface{}, user *roleMappingUser) (bool, error) {
+	var expectedValues []interface{}
+	if list, ok := expected.([]interface{}); ok {
+		expectedValues = list
+	} else {
+		expectedValues = []interface{}{expected}
+	}
+
+	isDn := name == "dn" || name == "groups"
+	actualValues := user.fieldValues(name)
+	for _, e := range expectedValues {
+		if e == nil {
+			if len(actualValues) == 0 {
+				return true, nil
+			}
+			continue
+		}
+		for _, a := range actualValues {
+			match, err := matchRoleMappingValue(e, a, isDn)
+			if err != nil {
+				return false, fmt.Errorf("invalid value for field `%s`: %w", name, err)
+			}
+			if match {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func matchRoleMappingValue(expected, actual interface{}, isDn bool) (bool, error) {
+	switch e := expected.(type) {
+	case string:
+		a, ok := actual.(string)
+		if !ok {
+			return false, nil
+		}
+		pattern, err := wildcardPattern(e)
+		if err != nil {
+			return false, err
+		}
+		if !isDn {
+			return pattern.MatchString(a), nil
+		}
+
+		dn := normalizeDn(a)
+		if pattern.MatchString(a) || pattern.MatchString(dn) || strings.EqualFold(e, a) || normalizeDn(e) == dn {
+			return true, nil
+		}
+		if parent, ok := strings.CutPrefix(e, "*,"); ok {
+			return strings.HasSuffix(dn, ","+normalizeDn(parent)), nil
+		}
+		return false, nil
+	case float64:
+		switch a := actual.(type) {
+		case float64:
+			return e == a, nil
+		case string:
+			f, err := strconv.ParseFloat(a, 64)
+			return err == nil && e == f, nil
+		}
+		return false, nil
+	case bool:
+		switch a := actual.(type) {
+		case bool:
+			return e == a, nil
+		case string:
+			b, err := strconv.ParseBool(a)
+			return err == nil && e == b, nil
+		}
+		return false, nil
+	}
+	return false, fmt.Errorf("unsupported value %v", expected)
+}
+
+// wildcardPattern compiles a value into a regular expression. Values enclosed in `/` are regular expressions,
+// others are matched literally apart from the `*` and `?` wildcards.
+func wildcardPattern(value string) (*regexp.Regexp, error) {
+	if len(value) > 1 && strings.HasPrefix(value, "/") && strings.HasSuffix(value, "/") {
+		return regexp.Compile("^(?:" + value[1:len(value)-1] + ")$")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	escaped := false
+	for _, r := range value {
+		switch {
+		case escaped:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '*':
+			sb.WriteString(".*")
+		case r == '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+// normalizeDn lower cases a distinguished name and removes the whitespace around its separators.
+func normalizeDn(dn string) string {
+	rdns := strings.Split(dn, ",")
+	for i, rdn := range rdns {
+		attr, value, ok := strings.Cut(rdn, "=")
+		if ok {
+			rdns[i] = strings.TrimSpace(attr) + "=" + strings.TrimSpace(value)
+		} else {
+			rdns[i] = strings.TrimSpace(rdn)
+		}
+	}
+	return strings.ToLower(strings.Join(rdns, ","))
+}
+
+// roleMappingRulesFromConfig returns the rules configured either as a `rule` block or as the JSON `rules` attribute.
+func roleMappingRulesFromConfig(d *schema.ResourceData) (map[string]interface{}, error) {
+	if v, ok := d.GetOk("rule"); ok {
+		rule := v.([]interface{})
+		if len(rule) > 0 && rule[0] != nil {
+			return expandRoleMappingRule(rule[0].(map[string]interface{}))
+		}
+	}
+
+	var rules map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("rules").(string)), &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
@@ -0,0 +1,137 @@
+package security
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateRoleMappingRule(t *testing.T) {
+	user := &roleMappingUser{
+		Username: "jdoe",
+		Dn:       "cn=John Doe,ou=people,dc=example,dc=com",
+		Groups:   []string{"cn=admins,ou=groups,dc=example,dc=com", "cn=devs,ou=groups,dc=example,dc=com"},
+		Realm:    "ldap1",
+		Metadata: map[string]interface{}{"level": float64(3), "teams": []interface{}{"search", "security"}},
+	}
+
+	tests := []struct {
+		name     string
+		rules    string
+		expected bool
+	}{
+		{name: "username", rules: `{"field":{"username":"jdoe"}}`, expected: true},
+		{name: "username mismatch", rules: `{"field":{"username":"admin"}}`, expected: false},
+		{name: "username wildcard", rules: `{"field":{"username":"j*"}}`, expected: true},
+		{name: "username single character wildcard", rules: `{"field":{"username":"j?oe"}}`, expected: true},
+		{name: "username regex", rules: `{"field":{"username":"/j[a-z]+/"}}`, expected: true},
+		{name: "username is case sensitive", rules: `{"field":{"username":"JDOE"}}`, expected: false},
+		{name: "any of the values", rules: `{"field":{"username":["admin","jdoe"]}}`, expected: true},
+		{name: "dn is normalized", rules: `{"field":{"dn":"CN=John Doe, OU=people, DC=example, DC=com"}}`, expected: true},
+		{name: "dn descendant", rules: `{"field":{"dn":"*,dc=example,dc=com"}}`, expected: true},
+		{name: "dn outside of the tree", rules: `{"field":{"dn":"*,dc=other,dc=com"}}`, expected: false},
+		{name: "groups", rules: `{"field":{"groups":"cn=devs,ou=groups,dc=example,dc=com"}}`, expected: true},
+		{name: "realm", rules: `{"field":{"realm.name":"ldap1"}}`, expected: true},
+		{name: "numeric metadata", rules: `{"field":{"metadata.level":3}}`, expected: true},
+		{name: "list metadata", rules: `{"field":{"metadata.teams":"security"}}`, expected: true},
+		{name: "missing metadata matches null", rules: `{"field":{"metadata.missing":null}}`, expected: true},
+		{name: "present field doesn't match null", rules: `{"field":{"username":null}}`, expected: false},
+		{name: "any", rules: `{"any":[{"field":{"username":"admin"}},{"field":{"realm.name":"ldap1"}}]}`, expected: true},
+		{name: "all", rules: `{"all":[{"field":{"username":"jdoe"}},{"field":{"realm.name":"file"}}]}`, expected: false},
+		{name: "except", rules: `{"all":[{"field":{"realm.name":"ldap1"}},{"except":{"field":{"groups":"cn=admins,ou=groups,dc=example,dc=com"}}}]}`, expected: false},
+		{name: "empty any", rules: `{"any":[]}`, expected: false},
+		{name: "empty all", rules: `{"all":[]}`, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rules map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(tt.rules), &rules))
+
+			match, err := evaluateRoleMappingRule(rules, user)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, match)
+		})
+	}
+}
+
+func TestEvaluateRoleMappingRuleErrors(t *testing.T) {
+	for _, rules := range []string{
+		`{}`,
+		`{"field":{"username":"jdoe"},"any":[]}`,
+		`{"unknown":{}}`,
+		`{"field":{"username":"/[/"}}`,
+	} {
+		var parsed map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(rules), &parsed))
+
+		_, err := evaluateRoleMappingRule(parsed, &roleMappingUser{Username: "jdoe"})
+		require.Error(t, err, rules)
+	}
+}
+
+func TestRoleMappingRuleRoundTrip(t *testing.T) {
+	rules := `{"any":[{"field":{"username":"esadmin"}},{"all":[{"field":{"groups":["cn=admins,dc=example,dc=com","cn=ops,dc=example,dc=com"]}},{"except":{"field":{"realm.name":"file"}}}]}]}`
+
+	var parsed map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(rules), &parsed))
+
+	rule, err := flattenRoleMappingRule(parsed, roleMappingRuleMaxDepth)
+	require.NoError(t, err)
+
+	expanded, err := expandRoleMappingRule(rule[0].(map[string]interface{}))
+	require.NoError(t, err)
+
+	actual, err := json.Marshal(expanded)
+	require.NoError(t, err)
+	require.JSONEq(t, rules, string(actual))
+}
+
+func TestFlattenRoleMappingRuleTooDeep(t *testing.T) {
+	var rules map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{"except":{"except":{"field":{"username":"jdoe"}}}}`), &rules))
+
+	_, err := flattenRoleMappingRule(rules, 1)
+	require.Error(t, err)
+}
+
+func TestRoleMappingRuleKeepsValueTypes(t *testing.T) {
+	rules := `{"all":[{"field":{"metadata.level":3}},{"field":{"metadata.contractor":false}},{"field":{"metadata.team":["search",2,true]}}]}`
+
+	var parsed map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(rules), &parsed))
+
+	rule, err := flattenRoleMappingRule(parsed, roleMappingRuleMaxDepth)
+	require.NoError(t, err)
+
+	level := rule[0].(map[string]interface{})["all"].([]interface{})[0].(map[string]interface{})["rule"].([]interface{})[0].(map[string]interface{})["field"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, []interface{}{}, level["values"])
+	require.Equal(t, []interface{}{float64(3)}, level["number_values"])
+
+	expanded, err := expandRoleMappingRule(rule[0].(map[string]interface{}))
+	require.NoError(t, err)
+
+	actual, err := json.Marshal(expanded)
+	require.NoError(t, err)
+	require.JSONEq(t, rules, string(actual))
+}
+
+func TestExpandRoleMappingRuleErrors(t *testing.T) {
+	for name, rule := range map[string]map[string]interface{}{
+		"empty rule": {},
+		"field without values": {
+			"field": []interface{}{map[string]interface{}{"name": "username"}},
+		},
+		"field and any": {
+			"field": []interface{}{map[string]interface{}{"name": "username", "values": []interface{}{"jdoe"}}},
+			"any":   []interface{}{map[string]interface{}{"rule": []interface{}{map[string]interface{}{"field": []interface{}{map[string]interface{}{"name": "username", "values": []interface{}{"jdoe"}}}}}}},
+		},
+		"empty except": {
+			"except": []interface{}{nil},
+		},
+	} {
+		_, err := expandRoleMappingRule(rule)
+		require.Error(t, err, name)
+	}
+}
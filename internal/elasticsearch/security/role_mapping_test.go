@@ -126,6 +126,95 @@ resource "elasticstack_elasticsearch_security_role_mapping" "test" {
 	`, roleMappingName)
 }
 
+func TestResourceRoleMappingRule(t *testing.T) {
+	roleMappingName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityRoleMappingDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityRoleMappingRule(roleMappingName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role_mapping.test", "name", roleMappingName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role_mapping.test", "rule.0.any.0.rule.0.field.0.name", "username"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role_mapping.test", "rule.0.any.0.rule.1.all.0.rule.1.except.0.field.0.values.0", "file"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role_mapping.test", "rules", `{"any":[{"field":{"username":"esadmin"}},{"all":[{"field":{"groups":["cn=admins,dc=example,dc=com","cn=ops,dc=example,dc=com"]}},{"except":{"field":{"realm.name":"file"}}}]}]}`),
+				),
+			},
+			{
+				Config: testAccResourceSecurityRoleMappingRuleUpdate(roleMappingName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role_mapping.test", "rule.0.field.0.name", "groups"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role_mapping.test", "rules", `{"field":{"groups":"cn=admins,dc=example,dc=com"}}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityRoleMappingRule(roleMappingName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role_mapping" "test" {
+  name  = "%s"
+  roles = ["admin"]
+
+  rule {
+    any {
+      rule {
+        field {
+          name   = "username"
+          values = ["esadmin"]
+        }
+      }
+      rule {
+        all {
+          rule {
+            field {
+              name   = "groups"
+              values = ["cn=admins,dc=example,dc=com", "cn=ops,dc=example,dc=com"]
+            }
+          }
+          rule {
+            except {
+              field {
+                name   = "realm.name"
+                values = ["file"]
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+	`, roleMappingName)
+}
+
+func testAccResourceSecurityRoleMappingRuleUpdate(roleMappingName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role_mapping" "test" {
+  name  = "%s"
+  roles = ["admin"]
+
+  rule {
+    field {
+      name   = "groups"
+      values = ["cn=admins,dc=example,dc=com"]
+    }
+  }
+}
+	`, roleMappingName)
+}
+
 func checkResourceSecurityRoleMappingDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
@@ -0,0 +1,131 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceRoleMappingTest() *schema.Resource {
+	roleMappingTestSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"rules": {
+			Description:  "The role mapping rules to evaluate, expressed using the JSON DSL.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			ExactlyOneOf: []string{"rules", "rule"},
+		},
+		"rule": {
+			Description:  "The role mapping rules to evaluate, as a typed alternative to `rules`. Each rule sets exactly one of `field`, `any`, `all` or `except`.",
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"rules", "rule"},
+			Elem:         roleMappingRuleSchema(roleMappingRuleMaxDepth),
+		},
+		"user": {
+			Description: "The sample user the rules are evaluated against.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"username": {
+						Description: "The username of the user.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"dn": {
+						Description: "The distinguished name of the user.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"groups": {
+						Description: "The groups the user belongs to, usually distinguished names for LDAP and Active Directory realms.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"realm": {
+						Description: "The name of the realm that authenticated the user.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"metadata": {
+						Description:  "The user metadata, available to the rules as `metadata.<key>` fields.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsJSON,
+					},
+				},
+			},
+		},
+		"match": {
+			Description: "Whether the rules match the user.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Evaluates role mapping rules against a sample user without connecting to Elasticsearch. Useful to test role mappings before applying them. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/role-mapping-resources.html",
+
+		ReadContext: dataSourceSecurityRoleMappingTestRead,
+
+		Schema: roleMappingTestSchema,
+	}
+}
+
+func dataSourceSecurityRoleMappingTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rules, err := roleMappingRulesFromConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// An empty user block is read as nil, hence the lenient type assertions.
+	userData, _ := d.Get("user").([]interface{})[0].(map[string]interface{})
+	user := roleMappingUser{}
+	user.Username, _ = userData["username"].(string)
+	user.Dn, _ = userData["dn"].(string)
+	user.Realm, _ = userData["realm"].(string)
+	if groups, ok := userData["groups"].([]interface{}); ok {
+		user.Groups = utils.ExpandStringList(groups)
+	}
+	if v, _ := userData["metadata"].(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &user.Metadata); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	match, err := evaluateRoleMappingRule(rules, &user)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("match", match); err != nil {
+		return diag.FromErr(err)
+	}
+
+	input, err := json.Marshal(map[string]interface{}{"rules": rules, "user": userData})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	hash, err := utils.StringToHash(string(input))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(*hash)
+
+	return nil
+}
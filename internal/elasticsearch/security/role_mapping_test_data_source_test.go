@@ -0,0 +1,87 @@
+package security_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityRoleMappingTest(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityRoleMappingTest,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_role_mapping_test.admin", "match", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_role_mapping_test.contractor", "match", "false"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_role_mapping_test.json", "match", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceSecurityRoleMappingTest = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+locals {
+  admins_rule = {
+    all = [
+      { field = { groups = "cn=admins,ou=groups,dc=example,dc=com" } },
+      { except = { field = { "metadata.contractor" = true } } },
+    ]
+  }
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_test" "admin" {
+  rule {
+    all {
+      rule {
+        field {
+          name   = "groups"
+          values = ["cn=admins,ou=groups,dc=example,dc=com"]
+        }
+      }
+      rule {
+        except {
+          field {
+            name        = "metadata.contractor"
+            bool_values = [true]
+          }
+        }
+      }
+    }
+  }
+
+  user {
+    username = "jdoe"
+    dn       = "cn=jdoe,ou=people,dc=example,dc=com"
+    groups   = ["CN=Admins, OU=Groups, DC=Example, DC=com"]
+    realm    = "ldap1"
+  }
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_test" "contractor" {
+  rules = jsonencode(local.admins_rule)
+
+  user {
+    username = "contractor"
+    groups   = ["cn=admins,ou=groups,dc=example,dc=com"]
+    metadata = jsonencode({ contractor = true })
+  }
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_test" "json" {
+  rules = jsonencode(local.admins_rule)
+
+  user {
+    username = "jdoe"
+    groups   = ["cn=admins,ou=groups,dc=example,dc=com"]
+  }
+}
+`
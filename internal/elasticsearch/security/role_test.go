@@ -6,6 +6,8 @@ import (
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/security"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -49,6 +51,40 @@ func TestAccResourceSecurityRole(t *testing.T) {
 	})
 }
 
+func TestAccResourceSecurityRoleRemoteIndices(t *testing.T) {
+	roleName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityRoleDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.RoleRemoteClusterMinVersion),
+				Config:   testAccResourceSecurityRoleRemoteIndices(roleName, "remote-*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role.test", "name", roleName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role.test", "description", "Role for remote-*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.*.clusters.*", "remote-*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.*.names.*", "logs-*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.*.privileges.*", "read"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_cluster.*.clusters.*", "remote-*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_cluster.*.privileges.*", "monitor_enrich"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.RoleRemoteClusterMinVersion),
+				Config:   testAccResourceSecurityRoleRemoteIndices(roleName, "other-*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role.test", "description", "Role for other-*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.*.clusters.*", "other-*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_cluster.*.clusters.*", "other-*"),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceSecurityRoleCreate(roleName string) string {
 	return fmt.Sprintf(`
 provider "elasticstack" {
@@ -102,6 +138,30 @@ resource "elasticstack_elasticsearch_security_role" "test" {
 	`, roleName)
 }
 
+func testAccResourceSecurityRoleRemoteIndices(roleName string, cluster string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role" "test" {
+  name        = "%[1]s"
+  description = "Role for %[2]s"
+
+  remote_indices {
+    clusters   = ["%[2]s"]
+    names      = ["logs-*"]
+    privileges = ["read", "read_cross_cluster"]
+  }
+
+  remote_cluster {
+    clusters   = ["%[2]s"]
+    privileges = ["monitor_enrich"]
+  }
+}
+	`, roleName, cluster)
+}
+
 func checkResourceSecurityRoleDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
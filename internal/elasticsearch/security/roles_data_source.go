@@ -0,0 +1,124 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceRoles() *schema.Resource {
+	rolesSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name_pattern": {
+			Description: "Only return the roles whose name matches this pattern. Supports the `*` and `?` wildcards, or a regular expression enclosed in `/`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "*",
+		},
+		"metadata": {
+			Description: "Only return the roles whose metadata contains all of these keys and values. Metadata values that aren't strings are compared using their JSON representation.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"roles": {
+			Description: "The matching roles, sorted by name.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        utils.ListElemSchema(DataSourceRole().Schema),
+		},
+	}
+
+	utils.AddConnectionSchema(rolesSchema)
+
+	return &schema.Resource{
+		Description: "Lists the roles in the native realm, including the built-in roles. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-get-role.html",
+
+		ReadContext: dataSourceSecurityRolesRead,
+
+		Schema: rolesSchema,
+	}
+}
+
+func dataSourceSecurityRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	filter, err := newSecurityListFilter(d, "name_pattern")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	roles, diags := elasticsearch.GetRoles(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+
+	result := make([]interface{}, 0, len(roles))
+	for _, name := range utils.SortedKeys(roles) {
+		role := roles[name]
+		if !filter.matches(name, role.Metadata) {
+			continue
+		}
+		flattened, err := flattenRole(name, &role)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		result = append(result, flattened)
+	}
+
+	id, diags := client.ID(ctx, d.Get("name_pattern").(string))
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if err := d.Set("roles", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func flattenRole(name string, role *models.Role) (map[string]interface{}, error) {
+	result := map[string]interface{}{
+		"name":           name,
+		"applications":   flattenApplicationsData(&role.Applications),
+		"cluster":        role.Cluster,
+		"indices":        flattenIndicesData(&role.Indices),
+		"remote_indices": flattenRemoteIndicesData(role.RemoteIndices),
+		"remote_cluster": flattenRemoteClusterData(role.RemoteCluster),
+		"run_as":         role.RusAs,
+	}
+	if role.Description != nil {
+		result["description"] = *role.Description
+	}
+	if role.Global != nil {
+		global, err := json.Marshal(role.Global)
+		if err != nil {
+			return nil, err
+		}
+		result["global"] = string(global)
+	}
+	if role.Metadata != nil {
+		metadata, err := json.Marshal(role.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		result["metadata"] = string(metadata)
+	}
+	return result, nil
+}
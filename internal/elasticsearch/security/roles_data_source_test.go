@@ -0,0 +1,72 @@
+package security_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityRoles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityRoles,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_roles.pattern", "roles.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_roles.pattern", "roles.0.name", "list-roles-a"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_roles.pattern", "roles.0.cluster.*", "monitor"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_roles.pattern", "roles.0.indices.*.names.*", "logs-*"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_roles.pattern", "roles.1.name", "list-roles-b"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_roles.metadata", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_roles.metadata", "roles.0.name", "list-roles-b"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_roles.builtin", "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_roles.builtin", "roles.0.cluster.*", "all"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceSecurityRoles = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role" "a" {
+  name    = "list-roles-a"
+  cluster = ["monitor"]
+  indices {
+    names      = ["logs-*"]
+    privileges = ["read"]
+  }
+  metadata = jsonencode({ owner = "search" })
+}
+
+resource "elasticstack_elasticsearch_security_role" "b" {
+  name     = "list-roles-b"
+  cluster  = ["monitor"]
+  metadata = jsonencode({ owner = "security" })
+}
+
+data "elasticstack_elasticsearch_security_roles" "pattern" {
+  name_pattern = "list-roles-?"
+
+  depends_on = [elasticstack_elasticsearch_security_role.a, elasticstack_elasticsearch_security_role.b]
+}
+
+data "elasticstack_elasticsearch_security_roles" "metadata" {
+  name_pattern = "list-roles-*"
+  metadata = {
+    owner = "security"
+  }
+
+  depends_on = [elasticstack_elasticsearch_security_role.a, elasticstack_elasticsearch_security_role.b]
+}
+
+data "elasticstack_elasticsearch_security_roles" "builtin" {
+  name_pattern = "superuser"
+}
+`
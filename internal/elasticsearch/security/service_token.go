@@ -0,0 +1,134 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceServiceToken() *schema.Resource {
+	serviceTokenSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"service_account": {
+			Description:  "The service account the token is created for, as `<namespace>/<service>`, e.g. `elastic/fleet-server`.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^/]+/[^/]+$`), "must be in the form <namespace>/<service>"),
+		},
+		"name": {
+			Description: "The name of the service account token.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 256),
+				validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-][a-zA-Z0-9_-]*$`), "must contain alphanumeric characters, dashes and underscores, and must not begin with an underscore"),
+			),
+		},
+		"value": {
+			Description: "The bearer token value, to be used in the `Authorization: Bearer` header.",
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(serviceTokenSchema)
+
+	return &schema.Resource{
+		Description: "Creates a service account token for access without requiring basic authentication. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-create-service-token.html",
+
+		CreateContext: resourceSecurityServiceTokenCreate,
+		ReadContext:   resourceSecurityServiceTokenRead,
+		// Only the connection settings can be updated in place.
+		UpdateContext: resourceSecurityServiceTokenRead,
+		DeleteContext: resourceSecurityServiceTokenDelete,
+
+		Schema: serviceTokenSchema,
+	}
+}
+
+func resourceSecurityServiceTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	serviceAccount := d.Get("service_account").(string)
+	namespace, service := splitServiceAccount(serviceAccount)
+	name := d.Get("name").(string)
+
+	token, diags := elasticsearch.CreateServiceToken(ctx, client, namespace, service, name)
+	if diags.HasError() {
+		return diags
+	}
+
+	id, diags := client.ID(ctx, fmt.Sprintf("%s/%s", serviceAccount, name))
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("value", token.Value); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return resourceSecurityServiceTokenRead(ctx, d, meta)
+}
+
+func resourceSecurityServiceTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	namespace, service := splitServiceAccount(d.Get("service_account").(string))
+	name := d.Get("name").(string)
+
+	credentials, diags := elasticsearch.GetServiceCredentials(ctx, client, namespace, service)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, ok := credentials.Tokens[name]; !ok {
+		tflog.Warn(ctx, fmt.Sprintf(`Service token "%s/%s/%s" not found, removing from state`, namespace, service, name))
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceSecurityServiceTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	namespace, service := splitServiceAccount(d.Get("service_account").(string))
+	if diags := elasticsearch.DeleteServiceToken(ctx, client, namespace, service, d.Get("name").(string)); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func splitServiceAccount(serviceAccount string) (string, string) {
+	namespace, service, _ := strings.Cut(serviceAccount, "/")
+	return namespace, service
+}
@@ -0,0 +1,89 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSecurityServiceToken(t *testing.T) {
+	tokenName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityServiceTokenDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityServiceToken(tokenName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_service_token.test", "service_account", "elastic/fleet-server"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_service_token.test", "name", tokenName),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_service_token.test", "value"),
+				),
+			},
+			{
+				// The token is created again when it was deleted outside of Terraform.
+				PreConfig: func() {
+					client, err := clients.NewAcceptanceTestingClient()
+					if err != nil {
+						t.Fatal(err)
+					}
+					if diags := elasticsearch.DeleteServiceToken(context.Background(), client, "elastic", "fleet-server", tokenName); diags.HasError() {
+						t.Fatalf("failed to delete service token: %v", diags)
+					}
+				},
+				Config: testAccResourceSecurityServiceToken(tokenName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_service_token.test", "name", tokenName),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_service_token.test", "value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityServiceToken(tokenName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_service_token" "test" {
+  service_account = "elastic/fleet-server"
+  name            = "%s"
+}
+	`, tokenName)
+}
+
+func checkResourceSecurityServiceTokenDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_security_service_token" {
+			continue
+		}
+
+		namespace, service, _ := strings.Cut(rs.Primary.Attributes["service_account"], "/")
+		credentials, diags := elasticsearch.GetServiceCredentials(context.Background(), client, namespace, service)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get service credentials: %v", diags)
+		}
+
+		if _, ok := credentials.Tokens[rs.Primary.Attributes["name"]]; ok {
+			return fmt.Errorf("Service token (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
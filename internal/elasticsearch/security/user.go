@@ -11,6 +11,7 @@ import (
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -40,7 +41,7 @@ func ResourceUser() *schema.Resource {
 			Optional:      true,
 			Sensitive:     true,
 			ValidateFunc:  validation.StringLenBetween(6, 128),
-			ConflictsWith: []string{"password_hash"},
+			ConflictsWith: []string{"password_hash", "password_wo"},
 		},
 		"password_hash": {
 			Description:   "A hash of the user’s password. This must be produced using the same hashing algorithm as has been configured for password storage (see https://www.elastic.co/guide/en/elasticsearch/reference/current/security-settings.html#hashing-settings).",
@@ -48,7 +49,36 @@ func ResourceUser() *schema.Resource {
 			Optional:      true,
 			Sensitive:     true,
 			ValidateFunc:  validation.StringLenBetween(6, 128),
-			ConflictsWith: []string{"password"},
+			ConflictsWith: []string{"password", "password_wo"},
+		},
+		"password_wo": {
+			Description:  "The user’s password, which is never stored in the state. It's hashed client-side with `password_hashing_algorithm`, and only the hash is sent and stored in `password_wo_hash`. The password is sent on creation, when it's added to an existing user and when `password_version` changes. Passwords hashed with bcrypt can't be longer than 72 bytes.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringLenBetween(6, 128),
+			// Keep the password out of the state, it's read from the configuration when it needs to be sent.
+			StateFunc:     func(interface{}) string { return "" },
+			ConflictsWith: []string{"password", "password_hash"},
+		},
+		"password_version": {
+			Description:  "An arbitrary version for `password_wo`. Change it to send the current `password_wo` again, e.g. to rotate the password.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			RequiredWith: []string{"password_wo"},
+		},
+		"password_hashing_algorithm": {
+			Description:  "The algorithm used to hash `password_wo`. It must match the `xpack.security.authc.password_hashing.algorithm` setting of the cluster. Defaults to `bcrypt`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(passwordHashingAlgorithms, false),
+			RequiredWith: []string{"password_wo"},
+		},
+		"password_wo_hash": {
+			Description: "The hash of `password_wo` sent to Elasticsearch.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
 		},
 		"full_name": {
 			Description: "The full name of the user.",
@@ -97,6 +127,8 @@ func ResourceUser() *schema.Resource {
 		ReadContext:   resourceSecurityUserRead,
 		DeleteContext: resourceSecurityUserDelete,
 
+		CustomizeDiff: resourceSecurityUserCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -128,6 +160,15 @@ func resourceSecurityUserPut(ctx context.Context, d *schema.ResourceData, meta i
 		user.PasswordHash = &pass_hash
 	}
 
+	password, hasPassword := configuredWriteOnlyPassword(d.GetRawConfig())
+	if hasPassword && writeOnlyPasswordChanged(d) {
+		passwordHash, err := hashPassword(password, writeOnlyPasswordHashingAlgorithm(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		user.PasswordHash = &passwordHash
+	}
+
 	if v, ok := d.GetOk("email"); ok {
 		user.Email = v.(string)
 	}
@@ -155,11 +196,73 @@ func resourceSecurityUserPut(ctx context.Context, d *schema.ResourceData, meta i
 	if diags := elasticsearch.PutUser(ctx, client, &user); diags.HasError() {
 		return diags
 	}
+	if !hasPassword {
+		if err := d.Set("password_wo_hash", ""); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if user.PasswordHash != nil {
+		if err := d.Set("password_wo_hash", *user.PasswordHash); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	d.SetId(id.String())
 	return resourceSecurityUserRead(ctx, d, meta)
 }
 
+func resourceSecurityUserCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// A new hash is computed whenever the write-only password is sent, even if the password isn't known yet.
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	if config.GetAttr("password_wo").IsNull() {
+		if d.Get("password_wo_hash").(string) != "" {
+			return d.SetNew("password_wo_hash", "")
+		}
+		return nil
+	}
+	if password, ok := configuredWriteOnlyPassword(config); ok {
+		if err := validatePasswordForAlgorithm(password, writeOnlyPasswordHashingAlgorithm(d)); err != nil {
+			return err
+		}
+	}
+	if writeOnlyPasswordChanged(d) {
+		return d.SetNewComputed("password_wo_hash")
+	}
+	return nil
+}
+
+// writeOnlyPasswordChanged returns whether `password_wo` has to be sent: on creation, when the version or the hashing
+// algorithm changes, and when it wasn't sent yet, e.g. when it replaces `password` on an existing user.
+func writeOnlyPasswordChanged(d interface {
+	Id() string
+	HasChanges(...string) bool
+	GetChange(string) (interface{}, interface{})
+}) bool {
+	oldHash, _ := d.GetChange("password_wo_hash")
+	return d.Id() == "" || d.HasChanges("password_version", "password_hashing_algorithm") || oldHash.(string) == ""
+}
+
+func writeOnlyPasswordHashingAlgorithm(d interface{ Get(string) interface{} }) string {
+	if algorithm := d.Get("password_hashing_algorithm").(string); algorithm != "" {
+		return algorithm
+	}
+	return defaultPasswordHashingAlgorithm
+}
+
+// configuredWriteOnlyPassword returns `password_wo` from the configuration, as it's never part of the plan or state.
+func configuredWriteOnlyPassword(config cty.Value) (string, bool) {
+	if config.IsNull() || !config.IsKnown() {
+		return "", false
+	}
+	password := config.GetAttr("password_wo")
+	if password.IsNull() || !password.IsKnown() {
+		return "", false
+	}
+	return password.AsString(), true
+}
+
 func resourceSecurityUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClientFromSDKResource(d, meta)
 	if diags.HasError() {
@@ -0,0 +1,264 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceUserPrivileges() *schema.Resource {
+	indexPrivilegesSchema := map[string]*schema.Schema{
+		"names": {
+			Description: "A list of indices (or index name patterns) to which the permissions in this entry apply.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"privileges": {
+			Description: "The index level privileges that the user has for the specified indices.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"field_security": {
+			Description: "The document fields that the user has read access to, one entry per role granting the privileges.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"grant": {
+						Description: "List of the fields to grant the access to.",
+						Type:        schema.TypeSet,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"except": {
+						Description: "List of the fields to which the grants will not be applied.",
+						Type:        schema.TypeSet,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"query": {
+			Description: "The search queries that define the documents the user has read access to.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"allow_restricted_indices": {
+			Description: "Whether the entry covers restricted indices.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+
+	remoteIndexPrivilegesSchema := make(map[string]*schema.Schema, len(indexPrivilegesSchema)+1)
+	for k, v := range indexPrivilegesSchema {
+		remoteIndexPrivilegesSchema[k] = v
+	}
+	remoteIndexPrivilegesSchema["clusters"] = &schema.Schema{
+		Description: "A list of remote clusters to which the permissions in this entry apply.",
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	userPrivilegesSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"username": {
+			Description:   "The user to get the privileges of, using run as. Requires the `run_as` privilege for this user. Defaults to the authenticated user.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"api_key"},
+		},
+		"api_key": {
+			Description:   "An encoded API key to get the privileges of, instead of the authenticated user.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ConflictsWith: []string{"username"},
+		},
+		"cluster": {
+			Description: "The cluster privileges of the user.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"global": {
+			Description: "The global privileges of the user, as a JSON string.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"indices": {
+			Description: "The index privileges of the user.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: indexPrivilegesSchema,
+			},
+		},
+		"remote_indices": {
+			Description: "The remote index privileges of the user.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: remoteIndexPrivilegesSchema,
+			},
+		},
+		"applications": {
+			Description: "The application privileges of the user.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"application": {
+						Description: "The name of the application.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"privileges": {
+						Description: "A list of the application privileges that the user has.",
+						Type:        schema.TypeSet,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"resources": {
+						Description: "A list of resources to which the privileges apply.",
+						Type:        schema.TypeSet,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"run_as": {
+			Description: "The users that the user can impersonate.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(userPrivilegesSchema)
+
+	return &schema.Resource{
+		Description: "Retrieves the privileges of a user or an API key, merged from all of their roles. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-get-user-privileges.html",
+		ReadContext: dataSourceSecurityUserPrivilegesRead,
+		Schema:      userPrivilegesSchema,
+	}
+}
+
+func dataSourceSecurityUserPrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	privileges, diags := elasticsearch.GetUserPrivileges(ctx, client, privilegesRequestHeaders(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	// The API doesn't return the user, the privileges of the authenticated user are keyed as `_current`.
+	username := "_current"
+	if v, ok := d.GetOk("username"); ok {
+		username = v.(string)
+	}
+	id, diags := client.ID(ctx, username)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if err := d.Set("cluster", privileges.Cluster); err != nil {
+		return diag.FromErr(err)
+	}
+	global, err := json.Marshal(privileges.Global)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("global", string(global)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("indices", flattenUserIndexPrivileges(privileges.Indices, false)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("remote_indices", flattenUserIndexPrivileges(privileges.RemoteIndices, true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	applications := make([]interface{}, len(privileges.Applications))
+	for i, application := range privileges.Applications {
+		applications[i] = map[string]interface{}{
+			"application": application.Name,
+			"privileges":  application.Privileges,
+			"resources":   application.Resources,
+		}
+	}
+	if err := d.Set("applications", applications); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("run_as", privileges.RunAs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenUserIndexPrivileges(indices []models.UserIndexPrivileges, remote bool) []interface{} {
+	result := make([]interface{}, len(indices))
+	for i, index := range indices {
+		fieldSecurity := make([]interface{}, len(index.FieldSecurity))
+		for j, fs := range index.FieldSecurity {
+			fieldSecurity[j] = map[string]interface{}{
+				"grant":  fs.Grant,
+				"except": fs.Except,
+			}
+		}
+
+		flattened := map[string]interface{}{
+			"names":                    index.Names,
+			"privileges":               index.Privileges,
+			"field_security":           fieldSecurity,
+			"query":                    index.Query,
+			"allow_restricted_indices": index.AllowRestrictedIndices,
+		}
+		if remote {
+			flattened["clusters"] = index.Clusters
+		}
+		result[i] = flattened
+	}
+	return result
+}
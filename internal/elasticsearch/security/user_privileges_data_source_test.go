@@ -0,0 +1,72 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityUserPrivileges(t *testing.T) {
+	username := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityUserPrivileges(username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_user_privileges.test", "cluster.*", "monitor"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_user_privileges.test", "indices.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.elasticstack_elasticsearch_security_user_privileges.test", "indices.*", map[string]string{
+						"names.#":                  "1",
+						"names.0":                  "logs-*",
+						"allow_restricted_indices": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.elasticstack_elasticsearch_security_user_privileges.test", "applications.*", map[string]string{
+						"application": "myapp",
+					}),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_user_privileges.test", "run_as.*", "other_user"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecurityUserPrivileges(username string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role" "test" {
+  name    = "%[1]s"
+  cluster = ["monitor"]
+  run_as  = ["other_user"]
+
+  indices {
+    names      = ["logs-*"]
+    privileges = ["read"]
+  }
+
+  applications {
+    application = "myapp"
+    privileges  = ["read"]
+    resources   = ["*"]
+  }
+}
+
+resource "elasticstack_elasticsearch_security_user" "test" {
+  username = "%[1]s"
+  roles    = [elasticstack_elasticsearch_security_role.test.name]
+  password = "qwerty123"
+}
+
+data "elasticstack_elasticsearch_security_user_privileges" "test" {
+  username = elasticstack_elasticsearch_security_user.test.username
+}
+`, username)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -160,6 +161,75 @@ func TestAccImportedUserDoesNotResetPassword(t *testing.T) {
 	})
 }
 
+func TestAccResourceSecurityUserWriteOnlyPassword(t *testing.T) {
+	username := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityUserDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserWriteOnlyPassword(username, "qwerty123", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_user.test", "username", username),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_user.test", "password_wo", ""),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_user.test", "password_wo_hash"),
+					checkUserCanAuthenticate(username, "qwerty123"),
+				),
+			},
+			{
+				// The password isn't sent again until the version changes.
+				Config: testAccResourceSecurityUserWriteOnlyPassword(username, "qwerty456", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_user.test", "password_wo", ""),
+					checkUserCanAuthenticate(username, "qwerty123"),
+				),
+			},
+			{
+				Config: testAccResourceSecurityUserWriteOnlyPassword(username, "qwerty456", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_user.test", "password_wo", ""),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_user.test", "password_version", "2"),
+					checkUserCanAuthenticate(username, "qwerty456"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecurityUserSwitchToWriteOnlyPassword(t *testing.T) {
+	username := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityUserDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserCreate(username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_user.test", "password_wo_hash", ""),
+					checkUserCanAuthenticate(username, "qwerty123"),
+				),
+			},
+			{
+				// The write-only password is sent when it replaces the password, even without a version.
+				Config: testAccResourceSecurityUserWriteOnlyPasswordNoVersion(username, "qwerty789"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_user.test", "password_wo", ""),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_user.test", "password_wo_hash"),
+					checkUserCanAuthenticate(username, "qwerty789"),
+				),
+			},
+			{
+				Config:      testAccResourceSecurityUserWriteOnlyPasswordNoVersion(username, strings.Repeat("a", 73)),
+				ExpectError: regexp.MustCompile("can't be longer than 72 bytes"),
+			},
+		},
+	})
+}
+
 func checkUserCanAuthenticate(username string, password string) func(*terraform.State) error {
 	return func(s *terraform.State) error {
 		client, err := clients.NewAcceptanceTestingClient()
@@ -237,6 +307,37 @@ resource "elasticstack_elasticsearch_security_user" "test" {
 	`, username, role)
 }
 
+func testAccResourceSecurityUserWriteOnlyPassword(username, password string, version int) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_user" "test" {
+  username         = "%s"
+  roles            = ["kibana_user"]
+  full_name        = "Test User"
+  password_wo      = "%s"
+  password_version = %d
+}
+	`, username, password, version)
+}
+
+func testAccResourceSecurityUserWriteOnlyPasswordNoVersion(username, password string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_user" "test" {
+  username    = "%s"
+  roles       = ["kibana_user"]
+  full_name   = "Test User"
+  password_wo = "%s"
+}
+	`, username, password)
+}
+
 func checkResourceSecurityUserDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
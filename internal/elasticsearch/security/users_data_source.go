@@ -0,0 +1,140 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceUsers() *schema.Resource {
+	usersSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"username_pattern": {
+			Description: "Only return the users whose username matches this pattern. Supports the `*` and `?` wildcards, or a regular expression enclosed in `/`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "*",
+		},
+		"metadata": {
+			Description: "Only return the users whose metadata contains all of these keys and values. Metadata values that aren't strings are compared using their JSON representation.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"users": {
+			Description: "The matching users, sorted by username.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        utils.ListElemSchema(DataSourceUser().Schema),
+		},
+	}
+
+	utils.AddConnectionSchema(usersSchema)
+
+	return &schema.Resource{
+		Description: "Lists the users in the native realm. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-get-user.html",
+
+		ReadContext: dataSourceSecurityUsersRead,
+
+		Schema: usersSchema,
+	}
+}
+
+func dataSourceSecurityUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	filter, err := newSecurityListFilter(d, "username_pattern")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	users, diags := elasticsearch.GetUsers(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+
+	result := make([]interface{}, 0, len(users))
+	for _, username := range utils.SortedKeys(users) {
+		user := users[username]
+		if !filter.matches(username, user.Metadata) {
+			continue
+		}
+		metadata, err := json.Marshal(user.Metadata)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		result = append(result, map[string]interface{}{
+			"username":  username,
+			"full_name": user.FullName,
+			"email":     user.Email,
+			"roles":     user.Roles,
+			"metadata":  string(metadata),
+			"enabled":   user.Enabled,
+		})
+	}
+
+	id, diags := client.ID(ctx, d.Get("username_pattern").(string))
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if err := d.Set("users", result); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// securityListFilter selects the entities returned by the list data sources, by name and metadata.
+type securityListFilter struct {
+	pattern  *regexp.Regexp
+	metadata map[string]interface{}
+}
+
+func newSecurityListFilter(d *schema.ResourceData, patternKey string) (*securityListFilter, error) {
+	pattern, err := wildcardPattern(d.Get(patternKey).(string))
+	if err != nil {
+		return nil, err
+	}
+	return &securityListFilter{
+		pattern:  pattern,
+		metadata: d.Get("metadata").(map[string]interface{}),
+	}, nil
+}
+
+func (f *securityListFilter) matches(name string, metadata map[string]interface{}) bool {
+	if !f.pattern.MatchString(name) {
+		return false
+	}
+	for key, expected := range f.metadata {
+		actual, ok := metadata[key]
+		if !ok {
+			return false
+		}
+		if s, ok := actual.(string); ok {
+			if s != expected {
+				return false
+			}
+			continue
+		}
+		if b, err := json.Marshal(actual); err != nil || string(b) != expected {
+			return false
+		}
+	}
+	return true
+}
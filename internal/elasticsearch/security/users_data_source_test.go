@@ -0,0 +1,66 @@
+package security_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityUsers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityUsers,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_users.pattern", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_users.pattern", "users.0.username", "list-users-a"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_users.pattern", "users.0.full_name", "User A"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_users.pattern", "users.0.roles.*", "kibana_admin"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_users.pattern", "users.1.username", "list-users-b"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_users.metadata", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_users.metadata", "users.0.username", "list-users-b"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_users.metadata", "users.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceSecurityUsers = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_user" "a" {
+  username  = "list-users-a"
+  password  = "qwerty123"
+  full_name = "User A"
+  roles     = ["kibana_admin"]
+  metadata  = jsonencode({ team = "search" })
+}
+
+resource "elasticstack_elasticsearch_security_user" "b" {
+  username = "list-users-b"
+  password = "qwerty123"
+  roles    = ["viewer"]
+  metadata = jsonencode({ team = "security", level = 2 })
+}
+
+data "elasticstack_elasticsearch_security_users" "pattern" {
+  username_pattern = "list-users-*"
+
+  depends_on = [elasticstack_elasticsearch_security_user.a, elasticstack_elasticsearch_security_user.b]
+}
+
+data "elasticstack_elasticsearch_security_users" "metadata" {
+  metadata = {
+    team  = "security"
+    level = "2"
+  }
+
+  depends_on = [elasticstack_elasticsearch_security_user.a, elasticstack_elasticsearch_security_user.b]
+}
+`
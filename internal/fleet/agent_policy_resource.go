@@ -2,6 +2,7 @@ package fleet
 
 import (
 	"context"
+	"fmt"
 
 	fleetapi "github.com/elastic/terraform-provider-elasticstack/generated/fleet"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
@@ -13,6 +14,9 @@ import (
 const (
 	monitorLogs    = "logs"
 	monitorMetrics = "metrics"
+
+	// defaultInactivityTimeout is the inactivity timeout Fleet assigns to agent policies, 2 weeks.
+	defaultInactivityTimeout = 1209600
 )
 
 func ResourceAgentPolicy() *schema.Resource {
@@ -74,6 +78,55 @@ func ResourceAgentPolicy() *schema.Resource {
 			Type:        schema.TypeBool,
 			Optional:    true,
 		},
+		"inactivity_timeout": {
+			Description: "The inactivity timeout for the agent policy, in seconds. If an agent does not report within this period, it is considered inactive.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     defaultInactivityTimeout,
+		},
+		"unenroll_timeout": {
+			Description: "The unenroll timeout for the agent policy, in seconds. If an agent is inactive for this period, it is automatically unenrolled. `0` disables the automatic unenrollment.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+		},
+		"agent_features": {
+			Description: "Agent features to enable or disable for agents enrolled in the policy.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the agent feature, e.g. `fqdn`.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"enabled": {
+						Description: "Whether the agent feature is enabled.",
+						Type:        schema.TypeBool,
+						Required:    true,
+					},
+				},
+			},
+		},
+		"is_protected": {
+			Description: "Enable tamper protection for agents enrolled in the policy. Requires a Platinum license or above. The uninstall token can be read with the `elasticstack_fleet_uninstall_token` data source.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"global_data_tags": {
+			Description: "Custom fields added to all the data ingested by agents enrolled in the policy.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"supports_agentless": {
+			Description: "Set to true if the agent policy is used by agentless integrations.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+		},
 		"skip_destroy": {
 			Description: "Set to true if you do not wish the agent policy to be deleted at destroy time, and instead just remove the agent policy from the Terraform state.",
 			Type:        schema.TypeBool,
@@ -131,6 +184,27 @@ func resourceAgentPolicyCreate(ctx context.Context, d *schema.ResourceData, meta
 		req.MonitoringOutputId = &value
 	}
 
+	if value := d.Get("inactivity_timeout").(int); value != 0 {
+		timeout := float32(value)
+		req.InactivityTimeout = &timeout
+	}
+	if value := d.Get("unenroll_timeout").(int); value != 0 {
+		timeout := float32(value)
+		req.UnenrollTimeout = &timeout
+	}
+	if value := d.Get("is_protected").(bool); value {
+		req.IsProtected = &value
+	}
+	if value := d.Get("supports_agentless").(bool); value {
+		req.SupportsAgentless = &value
+	}
+	if features := expandAgentFeatures(d); len(features) > 0 {
+		req.AgentFeatures = &features
+	}
+	if tags := expandGlobalDataTags(d); len(tags) > 0 {
+		req.GlobalDataTags = &tags
+	}
+
 	monitoringValues := make([]fleetapi.AgentPolicyCreateRequestMonitoringEnabled, 0, 2)
 	if value := d.Get("monitor_logs").(bool); value {
 		monitoringValues = append(monitoringValues, monitorLogs)
@@ -180,6 +254,28 @@ func resourceAgentPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta
 		req.MonitoringOutputId = &value
 	}
 
+	// Send the timeouts when they change, even to 0, so removing them from the configuration resets them
+	if value := d.Get("inactivity_timeout").(int); value != 0 || d.HasChange("inactivity_timeout") {
+		timeout := float32(value)
+		req.InactivityTimeout = &timeout
+	}
+	if value := d.Get("unenroll_timeout").(int); value != 0 || d.HasChange("unenroll_timeout") {
+		timeout := float32(value)
+		req.UnenrollTimeout = &timeout
+	}
+	if value := d.Get("is_protected").(bool); value || d.HasChange("is_protected") {
+		req.IsProtected = &value
+	}
+	if value := d.Get("supports_agentless").(bool); value {
+		req.SupportsAgentless = &value
+	}
+	if features := expandAgentFeatures(d); len(features) > 0 || d.HasChange("agent_features") {
+		req.AgentFeatures = &features
+	}
+	if tags := expandGlobalDataTags(d); len(tags) > 0 || d.HasChange("global_data_tags") {
+		req.GlobalDataTags = &tags
+	}
+
 	monitoringValues := make([]fleetapi.AgentPolicyUpdateRequestMonitoringEnabled, 0, 2)
 	if value := d.Get("monitor_logs").(bool); value {
 		monitoringValues = append(monitoringValues, monitorLogs)
@@ -248,6 +344,50 @@ func resourceAgentPolicyRead(ctx context.Context, d *schema.ResourceData, meta i
 			return diag.FromErr(err)
 		}
 	}
+	if agentPolicy.InactivityTimeout != nil {
+		if err := d.Set("inactivity_timeout", int(*agentPolicy.InactivityTimeout)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	unenrollTimeout := 0
+	if agentPolicy.UnenrollTimeout != nil {
+		unenrollTimeout = int(*agentPolicy.UnenrollTimeout)
+	}
+	if err := d.Set("unenroll_timeout", unenrollTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+	if agentPolicy.IsProtected != nil {
+		if err := d.Set("is_protected", *agentPolicy.IsProtected); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if agentPolicy.SupportsAgentless != nil {
+		if err := d.Set("supports_agentless", *agentPolicy.SupportsAgentless); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	// Features and tags removed outside of Terraform aren't returned anymore, reset them so the drift is detected.
+	features := make([]map[string]any, 0)
+	if agentPolicy.AgentFeatures != nil {
+		for _, v := range *agentPolicy.AgentFeatures {
+			features = append(features, map[string]any{
+				"name":    v.Name,
+				"enabled": v.Enabled,
+			})
+		}
+	}
+	if err := d.Set("agent_features", features); err != nil {
+		return diag.FromErr(err)
+	}
+	tags := make(map[string]any)
+	if agentPolicy.GlobalDataTags != nil {
+		for _, v := range *agentPolicy.GlobalDataTags {
+			tags[v.Name] = fmt.Sprint(v.Value)
+		}
+	}
+	if err := d.Set("global_data_tags", tags); err != nil {
+		return diag.FromErr(err)
+	}
 	if agentPolicy.MonitoringEnabled != nil {
 		for _, v := range *agentPolicy.MonitoringEnabled {
 			switch v {
@@ -285,3 +425,35 @@ func resourceAgentPolicyDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	return diags
 }
+
+type agentFeature = struct {
+	Enabled bool   `json:"enabled"`
+	Name    string `json:"name"`
+}
+
+func expandAgentFeatures(d *schema.ResourceData) []agentFeature {
+	values := d.Get("agent_features").(*schema.Set).List()
+	features := make([]agentFeature, 0, len(values))
+	for _, v := range values {
+		feature := v.(map[string]any)
+		features = append(features, agentFeature{
+			Name:    feature["name"].(string),
+			Enabled: feature["enabled"].(bool),
+		})
+	}
+
+	return features
+}
+
+func expandGlobalDataTags(d *schema.ResourceData) []fleetapi.AgentPolicyGlobalDataTagsItem {
+	values := d.Get("global_data_tags").(map[string]any)
+	tags := make([]fleetapi.AgentPolicyGlobalDataTagsItem, 0, len(values))
+	for k, v := range values {
+		tags = append(tags, fleetapi.AgentPolicyGlobalDataTagsItem{
+			Name:  k,
+			Value: v.(string),
+		})
+	}
+
+	return tags
+}
@@ -16,6 +16,7 @@ import (
 )
 
 var minVersionAgentPolicy = version.Must(version.NewVersion("8.6.0"))
+var minVersionAgentPolicyGlobalDataTags = version.Must(version.NewVersion("8.15.0"))
 
 func TestAccResourceAgentPolicy(t *testing.T) {
 	policyName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
@@ -77,6 +78,91 @@ func TestAccResourceAgentPolicySkipDestroy(t *testing.T) {
 	})
 }
 
+func TestAccResourceAgentPolicyAdvancedSettings(t *testing.T) {
+	policyName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceAgentPolicyDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(minVersionAgentPolicyGlobalDataTags),
+				Config:   testAccResourceAgentPolicyAdvancedSettings(policyName, true, "production"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_agent_policy.test_policy", "inactivity_timeout", "3600"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_agent_policy.test_policy", "unenroll_timeout", "7200"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_agent_policy.test_policy", "is_protected", "false"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_agent_policy.test_policy", "agent_features.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_fleet_agent_policy.test_policy", "agent_features.*", map[string]string{
+						"name":    "fqdn",
+						"enabled": "true",
+					}),
+					resource.TestCheckResourceAttr("elasticstack_fleet_agent_policy.test_policy", "global_data_tags.environment", "production"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_fleet_uninstall_token.test_policy", "token"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(minVersionAgentPolicyGlobalDataTags),
+				Config:   testAccResourceAgentPolicyAdvancedSettings(policyName, false, "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_agent_policy.test_policy", "inactivity_timeout", "1209600"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_agent_policy.test_policy", "unenroll_timeout", "0"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_agent_policy.test_policy", "global_data_tags.environment", "staging"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(minVersionAgentPolicyGlobalDataTags),
+				Config:   testAccResourceAgentPolicyCreate(policyName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_agent_policy.test_policy", "agent_features.#", "0"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_agent_policy.test_policy", "global_data_tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceAgentPolicyTamperProtection(t *testing.T) {
+	policyName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+	// Fleet only protects policies with the Elastic Defend integration, and requires a Platinum license, provided by the
+	// trial license of the test stack. The Elastic Defend version is pinned, and requires an 8.x stack from 8.15.0.
+	constraints, err := version.NewConstraint(">=8.15.0,<9.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceAgentPolicyDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(constraints),
+				Config:   testAccResourceAgentPolicyTamperProtection(policyName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_agent_policy.test_policy", "is_protected", "false"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(constraints),
+				Config:   testAccResourceAgentPolicyTamperProtection(policyName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_agent_policy.test_policy", "is_protected", "true"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_fleet_uninstall_token.test_policy", "token"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(constraints),
+				Config:   testAccResourceAgentPolicyTamperProtection(policyName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_agent_policy.test_policy", "is_protected", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceAgentPolicyCreate(id string, skipDestroy bool) string {
 	return fmt.Sprintf(`
 provider "elasticstack" {
@@ -122,6 +208,76 @@ data "elasticstack_fleet_enrollment_tokens" "test_policy" {
 `, fmt.Sprintf("Updated Policy %s", id), skipDestroy)
 }
 
+func testAccResourceAgentPolicyAdvancedSettings(id string, withTimeouts bool, environment string) string {
+	timeouts := ""
+	if withTimeouts {
+		timeouts = `
+  inactivity_timeout = 3600
+  unenroll_timeout   = 7200
+`
+	}
+
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_agent_policy" "test_policy" {
+  name        = "%s"
+  namespace   = "default"
+  description = "Test Agent Policy"
+%s
+  agent_features {
+    name    = "fqdn"
+    enabled = true
+  }
+
+  global_data_tags = {
+    environment = "%s"
+  }
+}
+
+data "elasticstack_fleet_uninstall_token" "test_policy" {
+  policy_id = elasticstack_fleet_agent_policy.test_policy.policy_id
+}
+`, fmt.Sprintf("Policy %s", id), timeouts, environment)
+}
+
+func testAccResourceAgentPolicyTamperProtection(id string, isProtected bool) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_integration" "defend" {
+  name    = "endpoint"
+  version = "8.15.0"
+  force   = true
+}
+
+resource "elasticstack_fleet_agent_policy" "test_policy" {
+  name         = "%s"
+  namespace    = "default"
+  description  = "Test Agent Policy"
+  is_protected = %t
+}
+
+resource "elasticstack_fleet_integration_policy" "defend" {
+  name                = "%s"
+  namespace           = "default"
+  agent_policy_id     = elasticstack_fleet_agent_policy.test_policy.policy_id
+  integration_name    = elasticstack_fleet_integration.defend.name
+  integration_version = elasticstack_fleet_integration.defend.version
+}
+
+data "elasticstack_fleet_uninstall_token" "test_policy" {
+  policy_id = elasticstack_fleet_agent_policy.test_policy.policy_id
+}
+`, fmt.Sprintf("Policy %s", id), isProtected, fmt.Sprintf("Defend %s", id))
+}
+
 func checkResourceAgentPolicyDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
@@ -0,0 +1,184 @@
+package fleet
+
+import (
+	"context"
+	"os"
+	"regexp"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceCustomIntegration() *schema.Resource {
+	customIntegrationSchema := map[string]*schema.Schema{
+		"package_path": {
+			Description:  "Path to the integration package archive to upload, either a `.zip` or a `.tar.gz` file.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`\.(zip|tar\.gz|tgz)$`), "must be a .zip or .tar.gz file"),
+		},
+		"checksum": {
+			Description: "The SHA-256 checksum of the package archive. The package is uploaded again when the checksum changes.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The integration package name, as read from the package manifest.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"version": {
+			Description: "The integration package version, as read from the package manifest.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"force": {
+			Description: "Set to true to force the uninstall of the package, even if it is in use.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"skip_destroy": {
+			Description: "Set to true if you do not wish the integration package to be uninstalled at destroy time, and instead just remove the integration package from the Terraform state.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Upload and install a custom Fleet integration package. See https://www.elastic.co/guide/en/fleet/current/install-uninstall-integration-assets.html",
+
+		CreateContext: resourceCustomIntegrationUpload,
+		ReadContext:   resourceCustomIntegrationRead,
+		UpdateContext: resourceCustomIntegrationUpload,
+		DeleteContext: resourceCustomIntegrationDelete,
+
+		CustomizeDiff: resourceCustomIntegrationCustomizeDiff,
+
+		Schema: customIntegrationSchema,
+	}
+}
+
+func resourceCustomIntegrationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	archivePath := d.Get("package_path").(string)
+	if archivePath == "" {
+		return nil
+	}
+
+	archive, err := readPackageArchive(archivePath)
+	if err != nil {
+		return err
+	}
+
+	if d.Get("checksum").(string) == archive.Checksum {
+		return nil
+	}
+
+	if err := d.SetNew("checksum", archive.Checksum); err != nil {
+		return err
+	}
+	if err := d.SetNew("name", archive.Name); err != nil {
+		return err
+	}
+	return d.SetNew("version", archive.Version)
+}
+
+func resourceCustomIntegrationUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fleetClient, diags := getFleetClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Only upload the package again when the archive content changed.
+	if d.Id() != "" && !d.HasChange("checksum") {
+		return resourceCustomIntegrationRead(ctx, d, meta)
+	}
+
+	archivePath := d.Get("package_path").(string)
+	archive, err := readPackageArchive(archivePath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer file.Close()
+
+	if diags = fleet.UploadPackage(ctx, fleetClient, archive.ContentType, file); diags.HasError() {
+		return diags
+	}
+
+	// Uninstall the previous package if the upload installed a different one. Fleet keeps a single installed version
+	// of a package, so a new version of the same package has replaced the previous one already.
+	if d.Id() != "" {
+		oldName, _ := d.GetChange("name")
+		oldVersion, _ := d.GetChange("version")
+		if oldName.(string) != archive.Name {
+			if diags = fleet.Uninstall(ctx, fleetClient, oldName.(string), oldVersion.(string), d.Get("force").(bool)); diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	d.SetId(getPackageID(archive.Name, archive.Version))
+	if err := d.Set("checksum", archive.Checksum); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", archive.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("version", archive.Version); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCustomIntegrationRead(ctx, d, meta)
+}
+
+func resourceCustomIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fleetClient, diags := getFleetClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+
+	if diags = fleet.ReadPackage(ctx, fleetClient, name, version); diags.HasError() {
+		// Not found.
+		if diags[0].Summary == fleet.ErrPackageNotFound.Error() {
+			tflog.Warn(ctx, "Custom integration package not found, removing from state", map[string]interface{}{"name": name, "version": version})
+			d.SetId("")
+			return nil
+		}
+		return diags
+	}
+
+	return nil
+}
+
+func resourceCustomIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+	force := d.Get("force").(bool)
+
+	if d.Get("skip_destroy").(bool) {
+		tflog.Debug(ctx, "Skipping uninstall of custom integration package", map[string]interface{}{"name": name, "version": version})
+		return nil
+	}
+
+	fleetClient, diags := getFleetClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags = fleet.Uninstall(ctx, fleetClient, name, version, force); diags.HasError() {
+		return diags
+	}
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,165 @@
+package fleet_test
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	fleetapi "github.com/elastic/terraform-provider-elasticstack/generated/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var minVersionCustomIntegration = version.Must(version.NewVersion("8.6.0"))
+
+func TestAccResourceCustomIntegration(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "tf_custom_integration.zip")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceCustomIntegrationDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc:  versionutils.CheckIfVersionIsUnsupported(minVersionCustomIntegration),
+				PreConfig: func() { writeCustomIntegrationArchive(t, archivePath, "0.1.0") },
+				Config:    testAccResourceCustomIntegration(archivePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_custom_integration.test", "name", "tf_custom_integration"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_custom_integration.test", "version", "0.1.0"),
+					resource.TestCheckResourceAttrSet("elasticstack_fleet_custom_integration.test", "checksum"),
+				),
+			},
+			{
+				SkipFunc:  versionutils.CheckIfVersionIsUnsupported(minVersionCustomIntegration),
+				PreConfig: func() { writeCustomIntegrationArchive(t, archivePath, "0.2.0") },
+				Config:    testAccResourceCustomIntegration(archivePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_custom_integration.test", "name", "tf_custom_integration"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_custom_integration.test", "version", "0.2.0"),
+					checkResourceCustomIntegrationInstalled("tf_custom_integration", "0.2.0"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(minVersionCustomIntegration),
+				Config:   testAccResourceCustomIntegration(archivePath),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccResourceCustomIntegration(archivePath string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_custom_integration" "test" {
+  package_path = "%s"
+  force        = true
+}
+`, archivePath)
+}
+
+func writeCustomIntegrationArchive(t *testing.T, archivePath, packageVersion string) {
+	files := map[string]string{
+		"manifest.yml": fmt.Sprintf(`format_version: 1.0.0
+name: tf_custom_integration
+title: Terraform custom integration
+version: %s
+description: Custom integration uploaded by the Terraform acceptance tests.
+type: integration
+categories:
+  - custom
+conditions:
+  kibana.version: "^8.0.0"
+owner:
+  github: elastic/terraform-provider-elasticstack
+`, packageVersion),
+		"changelog.yml": fmt.Sprintf(`- version: %s
+  changes:
+    - description: Test release
+      type: enhancement
+      link: https://github.com/elastic/terraform-provider-elasticstack
+`, packageVersion),
+		"docs/README.md": "# Terraform custom integration\n",
+	}
+
+	f, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	for name, content := range files {
+		fw, err := w.Create(fmt.Sprintf("tf_custom_integration-%s/%s", packageVersion, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = fw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func checkResourceCustomIntegrationInstalled(name, packageVersion string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingClient()
+		if err != nil {
+			return err
+		}
+		fleetClient, err := client.GetFleetClient()
+		if err != nil {
+			return err
+		}
+
+		resp, err := fleetClient.API.GetPackageWithResponse(context.Background(), name, packageVersion, &fleetapi.GetPackageParams{})
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return fmt.Errorf("unable to read custom integration %s-%s: %s", name, packageVersion, resp.Status())
+		}
+		if resp.JSON200.Status != fleetapi.Installed {
+			return fmt.Errorf("custom integration %s-%s is %s, but it should be installed", name, packageVersion, resp.JSON200.Status)
+		}
+		return nil
+	}
+}
+
+func checkResourceCustomIntegrationDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_fleet_custom_integration" {
+			continue
+		}
+
+		fleetClient, err := client.GetFleetClient()
+		if err != nil {
+			return err
+		}
+		diags := fleet.ReadPackage(context.Background(), fleetClient, rs.Primary.Attributes["name"], rs.Primary.Attributes["version"])
+		if !diags.HasError() {
+			return fmt.Errorf("custom integration %s is still installed, but it should have been removed", rs.Primary.Attributes["name"])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package fleet
+
+import (
+	"context"
+
+	fleetapi "github.com/elastic/terraform-provider-elasticstack/generated/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceEnrollmentToken() *schema.Resource {
+	enrollmentTokenSchema := map[string]*schema.Schema{
+		"key_id": {
+			Description: "The unique identifier of the enrollment token.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"policy_id": {
+			Description: "The identifier of the agent policy the Elastic Agents will be enrolled in.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the enrollment token. Fleet appends the token identifier to the name to keep it unique.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"rotation": {
+			Description: "Arbitrary map of values that, when changed, will revoke the enrollment token and create a new one. Use together with `lifecycle { create_before_destroy = true }` to create the new token before the old one is revoked.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"api_key": {
+			Description: "The enrollment token used to enroll Elastic Agents.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"api_key_id": {
+			Description: "The identifier of the API key backing the enrollment token.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created_at": {
+			Description: "The time at which the enrollment token was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"active": {
+			Description: "Indicates if the enrollment token is active.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Creates a new Fleet enrollment token for an agent policy, the token is revoked on destroy. See https://www.elastic.co/guide/en/fleet/current/fleet-enrollment-tokens.html",
+
+		CreateContext: resourceEnrollmentTokenCreate,
+		ReadContext:   resourceEnrollmentTokenRead,
+		DeleteContext: resourceEnrollmentTokenDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: enrollmentTokenSchema,
+	}
+}
+
+func resourceEnrollmentTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fleetClient, diags := getFleetClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	req := fleetapi.CreateEnrollmentApiKeysJSONRequestBody{
+		PolicyId: d.Get("policy_id").(string),
+	}
+	if value := d.Get("name").(string); value != "" {
+		req.Name = &value
+	}
+
+	token, diags := fleet.CreateEnrollmentToken(ctx, fleetClient, req)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(token.Id)
+	if err := d.Set("key_id", token.Id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceEnrollmentTokenRead(ctx, d, meta)
+}
+
+func resourceEnrollmentTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fleetClient, diags := getFleetClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	token, diags := fleet.ReadEnrollmentToken(ctx, fleetClient, d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	// Not found, or revoked outside of Terraform.
+	if token == nil || !token.Active {
+		tflog.Warn(ctx, "Enrollment token not found or revoked, removing from state", map[string]interface{}{"key_id": d.Id()})
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("key_id", token.Id); err != nil {
+		return diag.FromErr(err)
+	}
+	if token.PolicyId != nil {
+		if err := d.Set("policy_id", *token.PolicyId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("api_key", token.ApiKey); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("api_key_id", token.ApiKeyId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_at", token.CreatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("active", token.Active); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceEnrollmentTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fleetClient, diags := getFleetClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags = fleet.DeleteEnrollmentToken(ctx, fleetClient, d.Id()); diags.HasError() {
+		return diags
+	}
+	d.SetId("")
+
+	return diags
+}
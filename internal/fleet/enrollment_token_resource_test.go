@@ -0,0 +1,115 @@
+package fleet_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var minVersionEnrollmentToken = version.Must(version.NewVersion("8.6.0"))
+
+func TestAccResourceEnrollmentToken(t *testing.T) {
+	policyName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+	var firstKeyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceEnrollmentTokenDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(minVersionEnrollmentToken),
+				Config:   testAccResourceEnrollmentToken(policyName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("elasticstack_fleet_enrollment_token.test_token", "policy_id", "elasticstack_fleet_agent_policy.test_policy", "policy_id"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_enrollment_token.test_token", "name", "terraform"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_enrollment_token.test_token", "active", "true"),
+					resource.TestCheckResourceAttrSet("elasticstack_fleet_enrollment_token.test_token", "api_key"),
+					resource.TestCheckResourceAttrSet("elasticstack_fleet_enrollment_token.test_token", "api_key_id"),
+					func(s *terraform.State) error {
+						firstKeyID = s.RootModule().Resources["elasticstack_fleet_enrollment_token.test_token"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(minVersionEnrollmentToken),
+				Config:   testAccResourceEnrollmentToken(policyName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_enrollment_token.test_token", "rotation.version", "2"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_enrollment_token.test_token", "active", "true"),
+					func(s *terraform.State) error {
+						keyID := s.RootModule().Resources["elasticstack_fleet_enrollment_token.test_token"].Primary.ID
+						if keyID == firstKeyID {
+							return fmt.Errorf("expected enrollment token %s to be replaced on rotation", firstKeyID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceEnrollmentToken(id string, rotation string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_agent_policy" "test_policy" {
+  name        = "%s"
+  namespace   = "default"
+  description = "Agent Policy for testing Enrollment Token resource"
+}
+
+resource "elasticstack_fleet_enrollment_token" "test_token" {
+  policy_id = elasticstack_fleet_agent_policy.test_policy.policy_id
+  name      = "terraform"
+
+  rotation = {
+    version = "%s"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, fmt.Sprintf("Policy %s", id), rotation)
+}
+
+func checkResourceEnrollmentTokenDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_fleet_enrollment_token" {
+			continue
+		}
+
+		fleetClient, err := client.GetFleetClient()
+		if err != nil {
+			return err
+		}
+		token, diag := fleet.ReadEnrollmentToken(context.Background(), fleetClient, rs.Primary.ID)
+		if diag.HasError() {
+			return fmt.Errorf(diag[0].Summary)
+		}
+		if token != nil && token.Active {
+			return fmt.Errorf("enrollment token id=%v is still active, but it should have been revoked", rs.Primary.ID)
+		}
+	}
+	return checkResourceAgentPolicyDestroy(s)
+}
@@ -0,0 +1,120 @@
+package fleet
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// packageArchive describes a local integration package archive.
+type packageArchive struct {
+	Name        string
+	Version     string
+	Checksum    string
+	ContentType string
+}
+
+type packageManifest struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+}
+
+var manifestPath = regexp.MustCompile(`^[^/]+/manifest\.yml$`)
+
+// readPackageArchive computes the checksum of the archive at path and reads
+// the package name and version from its manifest.
+func readPackageArchive(archivePath string) (*packageArchive, error) {
+	data, err := os.ReadFile(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read package archive %q: %w", archivePath, err)
+	}
+
+	sum := sha256.Sum256(data)
+	archive := packageArchive{
+		Checksum: hex.EncodeToString(sum[:]),
+	}
+
+	var manifest []byte
+	if strings.HasSuffix(archivePath, ".zip") {
+		archive.ContentType = "application/zip"
+		manifest, err = readZipManifest(data)
+	} else {
+		archive.ContentType = "application/gzip"
+		manifest, err = readTarGzManifest(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read manifest from package archive %q: %w", archivePath, err)
+	}
+
+	var m packageManifest
+	if err := yaml.Unmarshal(manifest, &m); err != nil {
+		return nil, fmt.Errorf("unable to parse manifest from package archive %q: %w", archivePath, err)
+	}
+	if m.Name == "" || m.Version == "" {
+		return nil, fmt.Errorf("manifest in package archive %q must define both name and version", archivePath)
+	}
+	archive.Name = m.Name
+	archive.Version = m.Version
+
+	return &archive, nil
+}
+
+func readZipManifest(data []byte) ([]byte, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range r.File {
+		if !manifestPath.MatchString(path.Clean(f.Name)) {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+
+		return io.ReadAll(rc)
+	}
+
+	return nil, errors.New("manifest.yml not found")
+}
+
+func readTarGzManifest(data []byte) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	r := tar.NewReader(gz)
+	for {
+		hdr, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !manifestPath.MatchString(path.Clean(hdr.Name)) {
+			continue
+		}
+
+		return io.ReadAll(r)
+	}
+
+	return nil, errors.New("manifest.yml not found")
+}
@@ -0,0 +1,95 @@
+package fleet
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `format_version: 3.0.0
+name: custom_logs
+title: Custom logs
+version: 1.2.3
+type: integration
+`
+
+func writeTestZip(t *testing.T, files map[string]string) string {
+	archivePath := filepath.Join(t.TempDir(), "package.zip")
+	f, err := os.Create(archivePath)
+	require.NoError(t, err)
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	for name, content := range files {
+		fw, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	return archivePath
+}
+
+func writeTestTarGz(t *testing.T, files map[string]string) string {
+	archivePath := filepath.Join(t.TempDir(), "package.tar.gz")
+	f, err := os.Create(archivePath)
+	require.NoError(t, err)
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	w := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, w.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}))
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	require.NoError(t, gz.Close())
+
+	return archivePath
+}
+
+func Test_readPackageArchive(t *testing.T) {
+	files := map[string]string{
+		"custom_logs-1.2.3/manifest.yml":             testManifest,
+		"custom_logs-1.2.3/data_stream/manifest.yml": "title: Ignored\n",
+		"custom_logs-1.2.3/docs/README.md":           "# Custom logs\n",
+	}
+
+	t.Run("Zip", func(t *testing.T) {
+		archive, err := readPackageArchive(writeTestZip(t, files))
+		require.NoError(t, err)
+		require.Equal(t, "custom_logs", archive.Name)
+		require.Equal(t, "1.2.3", archive.Version)
+		require.Equal(t, "application/zip", archive.ContentType)
+		require.Len(t, archive.Checksum, 64)
+	})
+
+	t.Run("TarGz", func(t *testing.T) {
+		archive, err := readPackageArchive(writeTestTarGz(t, files))
+		require.NoError(t, err)
+		require.Equal(t, "custom_logs", archive.Name)
+		require.Equal(t, "1.2.3", archive.Version)
+		require.Equal(t, "application/gzip", archive.ContentType)
+	})
+
+	t.Run("MissingManifest", func(t *testing.T) {
+		_, err := readPackageArchive(writeTestZip(t, map[string]string{
+			"custom_logs-1.2.3/docs/README.md": "# Custom logs\n",
+		}))
+		require.ErrorContains(t, err, "manifest.yml not found")
+	})
+
+	t.Run("IncompleteManifest", func(t *testing.T) {
+		_, err := readPackageArchive(writeTestZip(t, map[string]string{
+			"custom_logs-1.2.3/manifest.yml": "name: custom_logs\n",
+		}))
+		require.ErrorContains(t, err, "must define both name and version")
+	})
+}
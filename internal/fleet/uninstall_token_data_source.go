@@ -0,0 +1,92 @@
+package fleet
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceUninstallToken() *schema.Resource {
+	uninstallTokenSchema := map[string]*schema.Schema{
+		"policy_id": {
+			Description: "The identifier of the protected agent policy.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"token_id": {
+			Description: "The identifier of the uninstall token.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"token": {
+			Description: "The uninstall token, required to uninstall or upgrade Elastic Agents enrolled in a protected agent policy.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"created_at": {
+			Description: "The time at which the uninstall token was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Retrieves the uninstall token of an agent policy with tamper protection enabled. See: https://www.elastic.co/guide/en/fleet/current/agent-tamper-protection.html",
+
+		ReadContext: dataSourceUninstallTokenRead,
+
+		Schema: uninstallTokenSchema,
+	}
+}
+
+func dataSourceUninstallTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fleetClient, diags := getFleetClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	policyID := d.Get("policy_id").(string)
+
+	tokens, diags := fleet.GetUninstallTokensByPolicy(ctx, fleetClient, policyID)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The policyId filter is a partial match, and the latest token is
+	// returned first.
+	var tokenID string
+	for _, v := range tokens {
+		if v.PolicyId == policyID {
+			tokenID = v.Id
+			break
+		}
+	}
+	if tokenID == "" {
+		return diag.Errorf("no uninstall token found for agent policy %q, ensure tamper protection is enabled", policyID)
+	}
+
+	token, diags := fleet.ReadUninstallToken(ctx, fleetClient, tokenID)
+	if diags.HasError() {
+		return diags
+	}
+	if token == nil {
+		return diag.Errorf("uninstall token %q not found", tokenID)
+	}
+
+	if err := d.Set("token_id", token.Id); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("token", token.Token); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_at", token.CreatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(policyID)
+
+	return diags
+}
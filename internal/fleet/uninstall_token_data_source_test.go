@@ -0,0 +1,56 @@
+package fleet_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var minVersionUninstallToken = version.Must(version.NewVersion("8.12.0"))
+
+func TestAccDataSourceUninstallToken(t *testing.T) {
+	policyName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		CheckDestroy:             checkResourceAgentPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(minVersionUninstallToken),
+				Config:   testAccDataSourceUninstallToken(policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.elasticstack_fleet_uninstall_token.test", "policy_id", "elasticstack_fleet_agent_policy.test", "policy_id"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_fleet_uninstall_token.test", "token_id"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_fleet_uninstall_token.test", "token"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_fleet_uninstall_token.test", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceUninstallToken(id string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_agent_policy" "test" {
+  name         = "%s"
+  namespace    = "default"
+  description  = "Agent Policy for testing Uninstall Tokens"
+  is_protected = true
+}
+
+data "elasticstack_fleet_uninstall_token" "test" {
+  policy_id = elasticstack_fleet_agent_policy.test.policy_id
+}
+`, fmt.Sprintf("Policy %s", id))
+}
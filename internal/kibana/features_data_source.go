@@ -0,0 +1,197 @@
+package kibana
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/disaster37/go-kibana-rest/v8/kbapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceFeatures() *schema.Resource {
+	featuresSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"features": {
+			Description: "The features registered in Kibana.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The identifier of the feature, used as the feature name in role privileges.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The display name of the feature.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"category": {
+						Description: "The identifier of the category the feature belongs to.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"privileges": {
+						Description: "The privileges that can be granted on the feature, usually `all` and `read`. Empty when the feature can't be granted with feature privileges.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"minimal_privileges": {
+						Description: "The minimal privileges of the feature, which grant the primary privilege without any of the sub-feature privileges.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"sub_features": {
+						Description: "The sub-features of the feature.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Description: "The display name of the sub-feature.",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"privileges": {
+									Description: "The privileges that can be granted on the sub-feature, in addition to a minimal privilege of the feature.",
+									Type:        schema.TypeList,
+									Computed:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+					"exclude_from_base_privileges": {
+						Description: "Whether the feature is excluded from the `all` and `read` base privileges, and must be granted explicitly.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Lists the features registered in Kibana, and the privileges that can be granted on them. See, https://www.elastic.co/guide/en/kibana/current/features-api-get.html",
+		ReadContext: dataSourceFeaturesRead,
+		Schema:      featuresSchema,
+	}
+}
+
+func dataSourceFeaturesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	kibana, err := client.GetKibanaClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	features, err := kibana.KibanaFeatures.List()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := make([]interface{}, len(features))
+	ids := make([]string, len(features))
+	for i, feature := range features {
+		category := ""
+		if feature.Category != nil {
+			category = feature.Category.ID
+		}
+		privileges, minimalPrivileges := kibanaFeaturePrivileges(feature)
+		subFeatures := make([]interface{}, len(feature.SubFeatures))
+		for j, subFeature := range feature.SubFeatures {
+			subFeatures[j] = map[string]interface{}{
+				"name":       subFeature.Name,
+				"privileges": kibanaSubFeaturePrivileges(subFeature),
+			}
+		}
+		result[i] = map[string]interface{}{
+			"id":                           feature.ID,
+			"name":                         feature.Name,
+			"category":                     category,
+			"privileges":                   privileges,
+			"minimal_privileges":           minimalPrivileges,
+			"sub_features":                 subFeatures,
+			"exclude_from_base_privileges": feature.ExcludeFromBasePrivileges,
+		}
+		ids[i] = feature.ID
+	}
+
+	if err := d.Set("features", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	input, err := json.Marshal(ids)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	hash, err := utils.StringToHash(string(input))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(*hash)
+
+	return nil
+}
+
+// kibanaFeaturePrivileges returns the primary and minimal privileges that can be granted on the feature.
+// Features without primary privileges can only be granted through base privileges, if at all.
+func kibanaFeaturePrivileges(feature kbapi.KibanaFeature) ([]string, []string) {
+	privileges := make([]string, 0, len(feature.Privileges))
+	for name := range feature.Privileges {
+		privileges = append(privileges, name)
+	}
+	sort.Strings(privileges)
+
+	minimalPrivileges := make([]string, 0)
+	if len(feature.SubFeatures) > 0 {
+		for _, name := range privileges {
+			minimalPrivileges = append(minimalPrivileges, "minimal_"+name)
+		}
+	}
+	return privileges, minimalPrivileges
+}
+
+func kibanaSubFeaturePrivileges(subFeature kbapi.KibanaFeatureSubFeature) []string {
+	privileges := make([]string, 0)
+	for _, group := range subFeature.PrivilegeGroups {
+		for _, privilege := range group.Privileges {
+			privileges = append(privileges, privilege.ID)
+		}
+	}
+	return privileges
+}
+
+// kibanaFeatureGrantablePrivileges returns all the privileges that can be granted on the feature in a role.
+func kibanaFeatureGrantablePrivileges(feature kbapi.KibanaFeature) []string {
+	privileges, minimalPrivileges := kibanaFeaturePrivileges(feature)
+	if len(privileges) == 0 {
+		return privileges
+	}
+	privileges = append(privileges, minimalPrivileges...)
+	for _, subFeature := range feature.SubFeatures {
+		privileges = append(privileges, kibanaSubFeaturePrivileges(subFeature)...)
+	}
+	return privileges
+}
@@ -0,0 +1,40 @@
+package kibana_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceKibanaFeatures(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceKibanaFeatures,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.elasticstack_kibana_features.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.elasticstack_kibana_features.test", "features.*", map[string]string{
+						"id":                   "discover",
+						"privileges.#":         "2",
+						"privileges.0":         "all",
+						"privileges.1":         "read",
+						"minimal_privileges.0": "minimal_all",
+						"minimal_privileges.1": "minimal_read",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceKibanaFeatures = `
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+data "elasticstack_kibana_features" "test" {}
+`
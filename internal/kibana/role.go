@@ -3,17 +3,24 @@ package kibana
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/disaster37/go-kibana-rest/v8/kbapi"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var RoleRemoteIndicesMinVersion = version.Must(version.NewVersion("8.10.0"))
+
 func ResourceRole() *schema.Resource {
 	roleSchema := map[string]*schema.Schema{
 		"name": {
@@ -95,6 +102,72 @@ func ResourceRole() *schema.Resource {
 							},
 						},
 					},
+					"remote_indices": {
+						Description: "A list of remote indices permissions entries, used for remote clusters connected with the API key based security model. Requires Elasticsearch 8.10 or later.",
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"clusters": {
+									Description: "A list of remote cluster aliases (or cluster alias patterns) to which the permissions in this entry apply.",
+									Type:        schema.TypeSet,
+									Required:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"field_security": {
+									Description: "The document fields that the owners of the role have read access to.",
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"grant": {
+												Description: "List of the fields to grant the access to.",
+												Type:        schema.TypeSet,
+												Optional:    true,
+												Elem: &schema.Schema{
+													Type: schema.TypeString,
+												},
+											},
+											"except": {
+												Description: "List of the fields to which the grants will not be applied.",
+												Type:        schema.TypeSet,
+												Optional:    true,
+												Elem: &schema.Schema{
+													Type: schema.TypeString,
+												},
+											},
+										},
+									},
+								},
+								"query": {
+									Description:      "A search query that defines the documents the owners of the role have read access to.",
+									Type:             schema.TypeString,
+									ValidateFunc:     validation.StringIsJSON,
+									DiffSuppressFunc: utils.DiffJsonSuppress,
+									Optional:         true,
+								},
+								"names": {
+									Description: "A list of indices (or index name patterns) to which the permissions in this entry apply.",
+									Type:        schema.TypeSet,
+									Required:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"privileges": {
+									Description: "The index level privileges that the owners of the role have on the specified indices.",
+									Type:        schema.TypeSet,
+									Required:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
 					"run_as": {
 						Description: "A list of usernames the owners of this role can impersonate.",
 						Type:        schema.TypeSet,
@@ -129,12 +202,12 @@ func ResourceRole() *schema.Resource {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"name": {
-									Description: "Feature name.",
+									Description: "Feature name. See the `elasticstack_kibana_features` data source for the features available in Kibana.",
 									Type:        schema.TypeString,
 									Required:    true,
 								},
 								"privileges": {
-									Description: "Feature privileges.",
+									Description: "Feature privileges, such as `all` or `read`. Sub-feature privileges are granted along with one of the `minimal_` privileges of the feature, for example `[\"minimal_read\", \"url_create\"]`.",
 									Type:        schema.TypeSet,
 									Required:    true,
 									Elem: &schema.Schema{
@@ -172,6 +245,7 @@ func ResourceRole() *schema.Resource {
 		UpdateContext: resourceRoleUpsert,
 		ReadContext:   resourceRoleRead,
 		DeleteContext: resourceRoleDelete,
+		CustomizeDiff: resourceRoleCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -181,6 +255,41 @@ func ResourceRole() *schema.Resource {
 	}
 }
 
+// resourceRoleCustomizeDiff validates the feature privileges against the features registered in Kibana,
+// so that typos are reported at plan time rather than when applying.
+func resourceRoleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if !d.HasChange("kibana") || rawConfig.IsNull() || !rawConfig.GetAttr("kibana").IsWhollyKnown() {
+		return nil
+	}
+
+	kibanaConfigs, diags := expandKibanaRoleKibana(d.Get("kibana"))
+	if diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+	hasFeatures := false
+	for _, config := range kibanaConfigs {
+		hasFeatures = hasFeatures || len(config.Feature) > 0
+	}
+	if !hasFeatures {
+		return nil
+	}
+
+	// Kibana may not be reachable yet when planning, for example when it's created in the same run.
+	// It validates the privileges again when the role is applied, so the validation is best effort.
+	kibana, err := meta.(*clients.ApiClient).GetKibanaClient()
+	if err != nil {
+		return nil
+	}
+	features, err := kibana.KibanaFeatures.List()
+	if err != nil {
+		tflog.Warn(ctx, "Unable to list the Kibana features, skipping the validation of the feature privileges", map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+
+	return validateKibanaRoleFeatures(kibanaConfigs, features)
+}
+
 func resourceRoleUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClientFromSDKResource(d, meta)
 	if diags.HasError() {
@@ -212,6 +321,16 @@ func resourceRoleUpsert(ctx context.Context, d *schema.ResourceData, meta interf
 		kibanaRole.Elasticsearch = expandKibanaRoleElasticsearch(v)
 	}
 
+	if len(kibanaRole.Elasticsearch.RemoteIndices) > 0 {
+		serverVersion, diags := client.ServerVersion(ctx)
+		if diags.HasError() {
+			return diags
+		}
+		if serverVersion.LessThan(RoleRemoteIndicesMinVersion) {
+			return diag.Errorf("'remote_indices' is supported only for Elasticsearch v%s and above", RoleRemoteIndicesMinVersion.String())
+		}
+	}
+
 	if v, ok := d.GetOk("metadata"); ok {
 		kibanaRole.Metadata, diags = expandKibanaRoleMetadata(v)
 		if diags != nil {
@@ -320,54 +439,26 @@ func expandKibanaRoleElasticsearch(v interface{}) *kbapi.KibanaRoleElasticsearch
 				definedIndices := v.(*schema.Set)
 				indices := make([]kbapi.KibanaRoleElasticsearchIndice, definedIndices.Len())
 				for i, idx := range definedIndices.List() {
-					index := idx.(map[string]interface{})
-
-					definedNames := index["names"].(*schema.Set)
-					names := make([]string, definedNames.Len())
-					for i, name := range definedNames.List() {
-						names[i] = name.(string)
-					}
-					definedPrivileges := index["privileges"].(*schema.Set)
-					privileges := make([]string, definedPrivileges.Len())
-					for i, pr := range definedPrivileges.List() {
-						privileges[i] = pr.(string)
-					}
-
-					newIndex := kbapi.KibanaRoleElasticsearchIndice{
-						Names:      names,
-						Privileges: privileges,
-					}
+					indices[i] = expandKibanaRoleIndice(idx.(map[string]interface{}))
+				}
+				elasticConfig.Indices = indices
+			}
 
-					if query := index["query"].(string); query != "" {
-						newIndex.Query = &query
-					}
-					if fieldSec := index["field_security"].([]interface{}); len(fieldSec) > 0 {
-						fieldSecurity := map[string]interface{}{}
-						// there must be only 1 entry
-						definedFieldSec := fieldSec[0].(map[string]interface{})
-
-						// grants
-						if gr := definedFieldSec["grant"].(*schema.Set); gr != nil {
-							grants := make([]string, gr.Len())
-							for i, grant := range gr.List() {
-								grants[i] = grant.(string)
-							}
-							fieldSecurity["grant"] = grants
-						}
-						// except
-						if exp := definedFieldSec["except"].(*schema.Set); exp != nil {
-							excepts := make([]string, exp.Len())
-							for i, except := range exp.List() {
-								excepts[i] = except.(string)
-							}
-							fieldSecurity["except"] = excepts
-						}
-						newIndex.FieldSecurity = fieldSecurity
+			if v, ok := userElasticConfig["remote_indices"]; ok {
+				definedIndices := v.(*schema.Set)
+				remoteIndices := make([]kbapi.KibanaRoleElasticsearchRemoteIndice, definedIndices.Len())
+				for i, idx := range definedIndices.List() {
+					index := idx.(map[string]interface{})
+					newIndex := expandKibanaRoleIndice(index)
+					remoteIndices[i] = kbapi.KibanaRoleElasticsearchRemoteIndice{
+						Clusters:      utils.ExpandStringSet(index["clusters"].(*schema.Set)),
+						Names:         newIndex.Names,
+						Privileges:    newIndex.Privileges,
+						FieldSecurity: newIndex.FieldSecurity,
+						Query:         newIndex.Query,
 					}
-
-					indices[i] = newIndex
 				}
-				elasticConfig.Indices = indices
+				elasticConfig.RemoteIndices = remoteIndices
 			}
 
 			if v, ok := userElasticConfig["run_as"]; ok {
@@ -383,6 +474,53 @@ func expandKibanaRoleElasticsearch(v interface{}) *kbapi.KibanaRoleElasticsearch
 	return elasticConfig
 }
 
+func expandKibanaRoleIndice(index map[string]interface{}) kbapi.KibanaRoleElasticsearchIndice {
+	definedNames := index["names"].(*schema.Set)
+	names := make([]string, definedNames.Len())
+	for i, name := range definedNames.List() {
+		names[i] = name.(string)
+	}
+	definedPrivileges := index["privileges"].(*schema.Set)
+	privileges := make([]string, definedPrivileges.Len())
+	for i, pr := range definedPrivileges.List() {
+		privileges[i] = pr.(string)
+	}
+
+	newIndex := kbapi.KibanaRoleElasticsearchIndice{
+		Names:      names,
+		Privileges: privileges,
+	}
+
+	if query := index["query"].(string); query != "" {
+		newIndex.Query = &query
+	}
+	if fieldSec := index["field_security"].([]interface{}); len(fieldSec) > 0 {
+		fieldSecurity := map[string]interface{}{}
+		// there must be only 1 entry
+		definedFieldSec := fieldSec[0].(map[string]interface{})
+
+		// grants
+		if gr := definedFieldSec["grant"].(*schema.Set); gr != nil {
+			grants := make([]string, gr.Len())
+			for i, grant := range gr.List() {
+				grants[i] = grant.(string)
+			}
+			fieldSecurity["grant"] = grants
+		}
+		// except
+		if exp := definedFieldSec["except"].(*schema.Set); exp != nil {
+			excepts := make([]string, exp.Len())
+			for i, except := range exp.List() {
+				excepts[i] = except.(string)
+			}
+			fieldSecurity["except"] = excepts
+		}
+		newIndex.FieldSecurity = fieldSecurity
+	}
+
+	return newIndex
+}
+
 func expandKibanaRoleKibana(v interface{}) ([]kbapi.KibanaRoleKibana, diag.Diagnostics) {
 	kibanaConfigs := []kbapi.KibanaRoleKibana{}
 	definedKibanaConfigs := v.(*schema.Set)
@@ -427,6 +565,44 @@ func expandKibanaRoleKibana(v interface{}) ([]kbapi.KibanaRoleKibana, diag.Diagn
 	return kibanaConfigs, nil
 }
 
+// validateKibanaRoleFeatures checks that the features granted by the role exist, and that their privileges are valid.
+func validateKibanaRoleFeatures(kibanaConfigs []kbapi.KibanaRoleKibana, features kbapi.KibanaFeatures) error {
+	grantablePrivileges := make(map[string][]string, len(features))
+	featureIds := make([]string, 0, len(features))
+	for _, feature := range features {
+		grantablePrivileges[feature.ID] = kibanaFeatureGrantablePrivileges(feature)
+		featureIds = append(featureIds, feature.ID)
+	}
+	sort.Strings(featureIds)
+
+	var errs []error
+	for _, config := range kibanaConfigs {
+		names := make([]string, 0, len(config.Feature))
+		for name := range config.Feature {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			privileges, ok := grantablePrivileges[name]
+			if !ok {
+				errs = append(errs, fmt.Errorf("unknown Kibana feature %q, expected one of: %s", name, strings.Join(featureIds, ", ")))
+				continue
+			}
+			if len(privileges) == 0 {
+				errs = append(errs, fmt.Errorf("the Kibana feature %q can't be granted with feature privileges", name))
+				continue
+			}
+			for _, privilege := range config.Feature[name] {
+				if !slices.Contains(privileges, privilege) {
+					errs = append(errs, fmt.Errorf("invalid privilege %q for the Kibana feature %q, expected one of: %s", privilege, name, strings.Join(privileges, ", ")))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func flattenKibanaRoleIndicesData(indices *[]kbapi.KibanaRoleElasticsearchIndice) []interface{} {
 	if indices != nil {
 		oindx := make([]interface{}, len(*indices))
@@ -454,6 +630,22 @@ func flattenKibanaRoleIndicesData(indices *[]kbapi.KibanaRoleElasticsearchIndice
 	return make([]interface{}, 0)
 }
 
+func flattenKibanaRoleRemoteIndicesData(remoteIndices []kbapi.KibanaRoleElasticsearchRemoteIndice) []interface{} {
+	oindx := make([]interface{}, len(remoteIndices))
+	for i, remoteIndex := range remoteIndices {
+		indices := []kbapi.KibanaRoleElasticsearchIndice{{
+			Names:         remoteIndex.Names,
+			Privileges:    remoteIndex.Privileges,
+			FieldSecurity: remoteIndex.FieldSecurity,
+			Query:         remoteIndex.Query,
+		}}
+		oi := flattenKibanaRoleIndicesData(&indices)[0].(map[string]interface{})
+		oi["clusters"] = remoteIndex.Clusters
+		oindx[i] = oi
+	}
+	return oindx
+}
+
 func flattenKibanaRoleElasticsearchData(elastic *kbapi.KibanaRoleElasticsearch) []interface{} {
 	if elastic != nil {
 		result := make(map[string]interface{})
@@ -461,6 +653,7 @@ func flattenKibanaRoleElasticsearchData(elastic *kbapi.KibanaRoleElasticsearch)
 			result["cluster"] = elastic.Cluster
 		}
 		result["indices"] = flattenKibanaRoleIndicesData(&elastic.Indices)
+		result["remote_indices"] = flattenKibanaRoleRemoteIndicesData(elastic.RemoteIndices)
 		if len(elastic.RunAs) > 0 {
 			result["run_as"] = elastic.RunAs
 		}
@@ -0,0 +1,67 @@
+package kibana
+
+import (
+	"testing"
+
+	"github.com/disaster37/go-kibana-rest/v8/kbapi"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateKibanaRoleFeatures(t *testing.T) {
+	features := kbapi.KibanaFeatures{
+		{
+			ID:         "discover",
+			Privileges: map[string]map[string]interface{}{"all": {}, "read": {}},
+			SubFeatures: []kbapi.KibanaFeatureSubFeature{
+				{
+					Name: "Short URLs",
+					PrivilegeGroups: []kbapi.KibanaFeatureSubFeaturePrivilegeGroup{
+						{GroupType: "independent", Privileges: []kbapi.KibanaFeatureSubFeaturePrivilege{{ID: "url_create"}}},
+					},
+				},
+			},
+		},
+		{
+			ID:         "dev_tools",
+			Privileges: map[string]map[string]interface{}{"all": {}, "read": {}},
+		},
+		{
+			ID: "enterpriseSearch",
+		},
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		err := validateKibanaRoleFeatures([]kbapi.KibanaRoleKibana{
+			{Feature: map[string][]string{"discover": {"minimal_read", "url_create"}, "dev_tools": {"all"}}},
+		}, features)
+		require.NoError(t, err)
+	})
+
+	t.Run("UnknownFeature", func(t *testing.T) {
+		err := validateKibanaRoleFeatures([]kbapi.KibanaRoleKibana{
+			{Feature: map[string][]string{"discovr": {"all"}}},
+		}, features)
+		require.EqualError(t, err, `unknown Kibana feature "discovr", expected one of: dev_tools, discover, enterpriseSearch`)
+	})
+
+	t.Run("InvalidPrivilege", func(t *testing.T) {
+		err := validateKibanaRoleFeatures([]kbapi.KibanaRoleKibana{
+			{Feature: map[string][]string{"discover": {"al"}}},
+		}, features)
+		require.EqualError(t, err, `invalid privilege "al" for the Kibana feature "discover", expected one of: all, read, minimal_all, minimal_read, url_create`)
+	})
+
+	t.Run("NoMinimalPrivilegesWithoutSubFeatures", func(t *testing.T) {
+		err := validateKibanaRoleFeatures([]kbapi.KibanaRoleKibana{
+			{Feature: map[string][]string{"dev_tools": {"minimal_read"}}},
+		}, features)
+		require.Error(t, err)
+	})
+
+	t.Run("FeatureWithoutPrivileges", func(t *testing.T) {
+		err := validateKibanaRoleFeatures([]kbapi.KibanaRoleKibana{
+			{Feature: map[string][]string{"enterpriseSearch": {"all"}}},
+		}, features)
+		require.EqualError(t, err, `the Kibana feature "enterpriseSearch" can't be granted with feature privileges`)
+	})
+}
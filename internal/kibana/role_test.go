@@ -2,11 +2,14 @@ package kibana_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/kibana"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -48,6 +51,50 @@ func TestAccResourceKibanaSecurityRole(t *testing.T) {
 	})
 }
 
+func TestAccResourceKibanaSecurityRoleRemoteIndices(t *testing.T) {
+	roleName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityRoleDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(kibana.RoleRemoteIndicesMinVersion),
+				Config:   testAccResourceSecurityRoleRemoteIndices(roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_kibana_security_role.test", "name", roleName),
+					utils.TestCheckResourceListAttr("elasticstack_kibana_security_role.test", "elasticsearch.0.remote_indices.0.clusters", []string{"remote-*"}),
+					utils.TestCheckResourceListAttr("elasticstack_kibana_security_role.test", "elasticsearch.0.remote_indices.0.names", []string{"sample"}),
+					utils.TestCheckResourceListAttr("elasticstack_kibana_security_role.test", "elasticsearch.0.remote_indices.0.field_security.0.grant", []string{"sample"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceKibanaSecurityRoleInvalidFeature(t *testing.T) {
+	roleName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityRoleDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceSecurityRoleFeature(roleName, "discover", "al"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`invalid privilege "al" for the Kibana feature "discover"`),
+			},
+			{
+				Config:      testAccResourceSecurityRoleFeature(roleName, "discovr", "all"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`unknown Kibana feature "discovr"`),
+			},
+		},
+	})
+}
+
 func testAccResourceSecurityRoleCreate(roleName string) string {
 	return fmt.Sprintf(`
 provider "elasticstack" {
@@ -137,6 +184,58 @@ resource "elasticstack_kibana_security_role" "test" {
 	`, roleName)
 }
 
+func testAccResourceSecurityRoleRemoteIndices(roleName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_kibana_security_role" "test" {
+  name    = "%s"
+  elasticsearch {
+    cluster = [ "create_snapshot" ]
+    remote_indices {
+      clusters = ["remote-*"]
+      field_security {
+        grant = ["sample"]
+        except = []
+      }
+      names = ["sample"]
+      privileges = ["read", "read_cross_cluster"]
+    }
+  }
+  kibana {
+    base = [ "read" ]
+    spaces = ["default"]
+  }
+}
+	`, roleName)
+}
+
+func testAccResourceSecurityRoleFeature(roleName, feature, privilege string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_kibana_security_role" "test" {
+  name = "%s"
+  elasticsearch {
+    cluster = ["monitor"]
+  }
+  kibana {
+    feature {
+      name       = "%s"
+      privileges = ["%s"]
+    }
+    spaces = ["default"]
+  }
+}
+	`, roleName, feature, privilege)
+}
+
 func checkResourceSecurityRoleDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
@@ -331,6 +331,87 @@ func ResourceSlo() *schema.Resource {
 				},
 			},
 		},
+		"timeslice_metric_indicator": {
+			Type:         schema.TypeList,
+			MinItems:     1,
+			MaxItems:     1,
+			Optional:     true,
+			ExactlyOneOf: indicatorAddresses,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"index": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"filter": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"timestamp_field": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "@timestamp",
+					},
+					"metric": {
+						Type:     schema.TypeList,
+						Required: true,
+						MinItems: 1,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"metrics": {
+									Type:     schema.TypeList,
+									Required: true,
+									MinItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"name": {
+												Description: "The name of the metric. Only valid options are A-Z.",
+												Type:        schema.TypeString,
+												Required:    true,
+											},
+											"aggregation": {
+												Description:  "The aggregation type of the metric. One of `sum`, `avg`, `min`, `max`, `std_deviation`, `last_value`, `cardinality`, `percentile` or `doc_count`.",
+												Type:         schema.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringInSlice([]string{"sum", "avg", "min", "max", "std_deviation", "last_value", "cardinality", "percentile", "doc_count"}, false),
+											},
+											"field": {
+												Description: "The field of the metric. Required for all aggregations but `doc_count`.",
+												Type:        schema.TypeString,
+												Optional:    true,
+											},
+											"percentile": {
+												Description: "The percentile value. Required for the `percentile` aggregation.",
+												Type:        schema.TypeFloat,
+												Optional:    true,
+											},
+											"filter": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+										},
+									},
+								},
+								"equation": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"comparator": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice([]string{"GT", "GTE", "LT", "LTE"}, false),
+								},
+								"threshold": {
+									Type:     schema.TypeFloat,
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"time_window": {
 			Description: "Currently support `calendarAligned` and `rolling` time windows. Any duration greater than 1 day can be used: days, weeks, months, quarters, years. Rolling time window requires a duration, e.g. `1w` for one week, and type: `rolling`. SLOs defined with such time window, will only consider the SLI data from the last duration period as a moving window. Calendar aligned time window requires a duration, limited to `1M` for monthly or `1w` for weekly, and type: `calendarAligned`.",
 			Type:        schema.TypeList,
@@ -398,6 +479,12 @@ func ResourceSlo() *schema.Resource {
 						Optional: true,
 						Computed: true,
 					},
+					"prevent_initial_backfill": {
+						Description: "Prevents the underlying transform from backfilling data on start, so only data ingested after the SLO is created is considered.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
 				},
 			},
 		},
@@ -409,10 +496,13 @@ func ResourceSlo() *schema.Resource {
 			ForceNew:    true,
 		},
 		"group_by": {
-			Description: "Optional group by field to use to generate an SLO per distinct value.",
-			Type:        schema.TypeString,
+			Description: "Optional group by fields to use to generate an SLO per distinct value. Grouping by more than one field requires Kibana 8.14 or later.",
+			Type:        schema.TypeList,
 			Optional:    true,
 			ForceNew:    false,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
 		},
 		"tags": {
 			Description: "The tags for the SLO.",
@@ -437,8 +527,40 @@ func ResourceSlo() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: sloSchema,
+		Schema:        sloSchema,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceSloV0(sloSchema).CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceSloStateUpgradeV0,
+			},
+		},
+	}
+}
+
+// resourceSloV0 is the schema before group_by accepted a list of fields.
+func resourceSloV0(sloSchema map[string]*schema.Schema) *schema.Resource {
+	v0Schema := make(map[string]*schema.Schema, len(sloSchema))
+	for k, v := range sloSchema {
+		v0Schema[k] = v
+	}
+	v0Schema["group_by"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{Schema: v0Schema}
+}
+
+func resourceSloStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if groupBy, ok := rawState["group_by"].(string); ok && groupBy != "" {
+		rawState["group_by"] = []interface{}{groupBy}
+	} else {
+		delete(rawState, "group_by")
 	}
+
+	return rawState, nil
 }
 
 func getOrNilString(path string, d *schema.ResourceData) *string {
@@ -583,6 +705,63 @@ func getSloFromResourceData(d *schema.ResourceData) (models.Slo, diag.Diagnostic
 			},
 		}
 
+	case "timeslice_metric_indicator":
+		metricsRaw := d.Get(indicatorType + ".0.metric.0.metrics").([]interface{})
+		var metrics []slo.IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner
+		for n := range metricsRaw {
+			idx := fmt.Sprint(n)
+			name := d.Get(indicatorType + ".0.metric.0.metrics." + idx + ".name").(string)
+			aggregation := d.Get(indicatorType + ".0.metric.0.metrics." + idx + ".aggregation").(string)
+			field := d.Get(indicatorType + ".0.metric.0.metrics." + idx + ".field").(string)
+			filter := getOrNilString(indicatorType+".0.metric.0.metrics."+idx+".filter", d)
+
+			switch aggregation {
+			case "doc_count":
+				metrics = append(metrics, slo.IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner{
+					TimesliceMetricDocCountMetric: &slo.TimesliceMetricDocCountMetric{
+						Name:        name,
+						Aggregation: aggregation,
+						Filter:      filter,
+					},
+				})
+			case "percentile":
+				metrics = append(metrics, slo.IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner{
+					TimesliceMetricPercentileMetric: &slo.TimesliceMetricPercentileMetric{
+						Name:        name,
+						Aggregation: aggregation,
+						Field:       field,
+						Percentile:  d.Get(indicatorType + ".0.metric.0.metrics." + idx + ".percentile").(float64),
+						Filter:      filter,
+					},
+				})
+			default:
+				metrics = append(metrics, slo.IndicatorPropertiesTimesliceMetricParamsMetricMetricsInner{
+					TimesliceMetricBasicMetricWithField: &slo.TimesliceMetricBasicMetricWithField{
+						Name:        name,
+						Aggregation: aggregation,
+						Field:       field,
+						Filter:      filter,
+					},
+				})
+			}
+		}
+		indicator = slo.SloResponseIndicator{
+			IndicatorPropertiesTimesliceMetric: &slo.IndicatorPropertiesTimesliceMetric{
+				Type: indicatorAddressToType[indicatorType],
+				Params: slo.IndicatorPropertiesTimesliceMetricParams{
+					Filter:         getOrNilString(indicatorType+".0.filter", d),
+					Index:          d.Get(indicatorType + ".0.index").(string),
+					TimestampField: d.Get(indicatorType + ".0.timestamp_field").(string),
+					Metric: slo.IndicatorPropertiesTimesliceMetricParamsMetric{
+						Metrics:    metrics,
+						Equation:   d.Get(indicatorType + ".0.metric.0.equation").(string),
+						Comparator: d.Get(indicatorType + ".0.metric.0.comparator").(string),
+						Threshold:  d.Get(indicatorType + ".0.metric.0.threshold").(float64),
+					},
+				},
+			},
+		}
+
 	default:
 		return models.Slo{}, diag.Errorf("unknown indicator type %s", indicatorType)
 	}
@@ -602,6 +781,10 @@ func getSloFromResourceData(d *schema.ResourceData) (models.Slo, diag.Diagnostic
 		SyncDelay: getOrNilString("settings.0.sync_delay", d),
 		Frequency: getOrNilString("settings.0.frequency", d),
 	}
+	// Only send prevent_initial_backfill when enabled or explicitly disabled, older Kibana versions don't know about it.
+	if preventInitialBackfill := d.Get("settings.0.prevent_initial_backfill").(bool); preventInitialBackfill || d.HasChange("settings.0.prevent_initial_backfill") {
+		settings.PreventInitialBackfill = &preventInitialBackfill
+	}
 
 	budgetingMethod := slo.BudgetingMethod(d.Get("budgeting_method").(string))
 
@@ -614,7 +797,6 @@ func getSloFromResourceData(d *schema.ResourceData) (models.Slo, diag.Diagnostic
 		Objective:       objective,
 		Settings:        &settings,
 		SpaceID:         d.Get("space_id").(string),
-		GroupBy:         getOrNilString("group_by", d),
 	}
 
 	// Explicitly set SLO object id if provided, otherwise we'll use the autogenerated ID from the Kibana API response
@@ -628,6 +810,12 @@ func getSloFromResourceData(d *schema.ResourceData) (models.Slo, diag.Diagnostic
 		}
 	}
 
+	if groupBy, ok := d.GetOk("group_by"); ok {
+		for _, g := range groupBy.([]interface{}) {
+			slo.GroupBy = append(slo.GroupBy, g.(string))
+		}
+	}
+
 	return slo, diags
 }
 
@@ -665,6 +853,11 @@ func resourceSloUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diags
 	}
 
+	// Kibana keeps the current grouping when groupBy is omitted, reset it explicitly when group_by is removed.
+	if d.HasChange("group_by") && len(slo.GroupBy) == 0 {
+		slo.GroupBy = []string{kibana.SloGroupByAll}
+	}
+
 	res, diags := kibana.UpdateSlo(ctx, client, slo)
 
 	if diags.HasError() {
@@ -799,6 +992,48 @@ func resourceSloRead(ctx context.Context, d *schema.ResourceData, meta interface
 			"total":           total,
 		})
 
+	case s.Indicator.IndicatorPropertiesTimesliceMetric != nil:
+		indicatorAddress = indicatorTypeToAddress[s.Indicator.IndicatorPropertiesTimesliceMetric.Type]
+		params := s.Indicator.IndicatorPropertiesTimesliceMetric.Params
+		metrics := []map[string]interface{}{}
+		for _, m := range params.Metric.Metrics {
+			switch {
+			case m.TimesliceMetricBasicMetricWithField != nil:
+				metrics = append(metrics, map[string]interface{}{
+					"name":        m.TimesliceMetricBasicMetricWithField.Name,
+					"aggregation": m.TimesliceMetricBasicMetricWithField.Aggregation,
+					"field":       m.TimesliceMetricBasicMetricWithField.Field,
+					"filter":      m.TimesliceMetricBasicMetricWithField.Filter,
+				})
+			case m.TimesliceMetricPercentileMetric != nil:
+				metrics = append(metrics, map[string]interface{}{
+					"name":        m.TimesliceMetricPercentileMetric.Name,
+					"aggregation": m.TimesliceMetricPercentileMetric.Aggregation,
+					"field":       m.TimesliceMetricPercentileMetric.Field,
+					"percentile":  m.TimesliceMetricPercentileMetric.Percentile,
+					"filter":      m.TimesliceMetricPercentileMetric.Filter,
+				})
+			case m.TimesliceMetricDocCountMetric != nil:
+				metrics = append(metrics, map[string]interface{}{
+					"name":        m.TimesliceMetricDocCountMetric.Name,
+					"aggregation": m.TimesliceMetricDocCountMetric.Aggregation,
+					"filter":      m.TimesliceMetricDocCountMetric.Filter,
+				})
+			}
+		}
+		metric := []map[string]interface{}{{
+			"metrics":    metrics,
+			"equation":   params.Metric.Equation,
+			"comparator": params.Metric.Comparator,
+			"threshold":  params.Metric.Threshold,
+		}}
+		indicator = append(indicator, map[string]interface{}{
+			"index":           params.Index,
+			"filter":          params.Filter,
+			"timestamp_field": params.TimestampField,
+			"metric":          metric,
+		})
+
 	default:
 		return diag.Errorf("indicator not set")
 	}
@@ -830,17 +1065,16 @@ func resourceSloRead(ctx context.Context, d *schema.ResourceData, meta interface
 
 	if err := d.Set("settings", []interface{}{
 		map[string]interface{}{
-			"sync_delay": s.Settings.SyncDelay,
-			"frequency":  s.Settings.Frequency,
+			"sync_delay":               s.Settings.SyncDelay,
+			"frequency":                s.Settings.Frequency,
+			"prevent_initial_backfill": s.Settings.GetPreventInitialBackfill(),
 		},
 	}); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if s.GroupBy != nil {
-		if err := d.Set("group_by", s.GroupBy); err != nil {
-			return diag.FromErr(err)
-		}
+	if err := d.Set("group_by", s.GroupBy); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("slo_id", s.SloID); err != nil {
@@ -892,6 +1126,7 @@ var indicatorAddressToType = map[string]string{
 	"kql_custom_indicator":       "sli.kql.custom",
 	"metric_custom_indicator":    "sli.metric.custom",
 	"histogram_custom_indicator": "sli.histogram.custom",
+	"timeslice_metric_indicator": "sli.metric.timeslice",
 }
 
 var indicatorTypeToAddress = utils.FlipMap(indicatorAddressToType)
@@ -0,0 +1,233 @@
+package kibana
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibana"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSlo() *schema.Resource {
+	sloSchema := map[string]*schema.Schema{
+		"slo_id": {
+			Description:  "The identifier of the SLO. Exactly one of `slo_id` or `name` must be set.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"slo_id", "name"},
+		},
+		"name": {
+			Description:  "The name of the SLO. The data source fails if more than one SLO shares the same name.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"slo_id", "name"},
+		},
+		"space_id": {
+			Description: "An identifier for the space. If space_id is not provided, the default space is used.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "default",
+		},
+		"description": {
+			Description: "The description of the SLO.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"budgeting_method": {
+			Description: "The budgeting method of the SLO, either `occurrences` or `timeslices`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"group_by": {
+			Description: "The fields used to generate an SLO per distinct value.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tags": {
+			Description: "The tags of the SLO.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"summary": {
+			Description: "The current status and error budget of the SLO.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: sloSummarySchema(),
+			},
+		},
+		"instances": {
+			Description: "The current status and error budget of each instance of a grouped SLO.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: utils.MergeSchemaMaps(sloSummarySchema(), map[string]*schema.Schema{
+					"instance_id": {
+						Description: "The value of the group by fields for this instance.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				}),
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Retrieves an SLO, along with its current status and error budget, by ID or name.",
+
+		ReadContext: dataSourceSloRead,
+
+		Schema: sloSchema,
+	}
+}
+
+func sloSummarySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"status": {
+			Description: "The status of the SLO, one of `NO_DATA`, `HEALTHY`, `DEGRADING` or `VIOLATED`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"sli_value": {
+			Description: "The current SLI value.",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+		"error_budget_initial": {
+			Description: "The initial error budget, as 1 - objective.",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+		"error_budget_consumed": {
+			Description: "The error budget consumed, as a percentage of the initial error budget.",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+		"error_budget_remaining": {
+			Description: "The error budget remaining, as a percentage of the initial error budget.",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+		"error_budget_is_estimated": {
+			Description: "Whether the error budget is estimated, only for SLOs using the occurrences budgeting method and a calendar aligned time window.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+}
+
+func dataSourceSloRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClientFromSDKResource(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	spaceID := d.Get("space_id").(string)
+	sloID := d.Get("slo_id").(string)
+
+	if sloID == "" {
+		name := d.Get("name").(string)
+		found, diags := kibana.FindSlos(ctx, client, spaceID, fmt.Sprintf("slo.name:%s", kqlQuote(name)))
+		if diags.HasError() {
+			return diags
+		}
+
+		// Grouped SLOs are returned once per instance, and the KQL query isn't an exact match.
+		for _, s := range found {
+			if s.Name != name || s.SloID == sloID {
+				continue
+			}
+			if sloID != "" {
+				return diag.Errorf("multiple SLOs found with name [%s/%s]", spaceID, name)
+			}
+			sloID = s.SloID
+		}
+		if sloID == "" {
+			return diag.Errorf("SLO with name [%s/%s] not found", spaceID, name)
+		}
+	}
+
+	s, diags := kibana.GetSlo(ctx, client, sloID, spaceID)
+	if diags.HasError() {
+		return diags
+	}
+	if s == nil {
+		return diag.Errorf("SLO with ID [%s/%s] not found", spaceID, sloID)
+	}
+
+	instances := []interface{}{}
+	if len(s.GroupBy) > 0 {
+		found, diags := kibana.FindSlos(ctx, client, spaceID, fmt.Sprintf("slo.id:%s", kqlQuote(sloID)))
+		if diags.HasError() {
+			return diags
+		}
+
+		for _, instance := range found {
+			if instance.SloID != sloID {
+				continue
+			}
+			summary := flattenSloSummary(instance)
+			summary["instance_id"] = instance.InstanceID
+			instances = append(instances, summary)
+		}
+	}
+
+	compositeID := &clients.CompositeId{ClusterId: spaceID, ResourceId: s.SloID}
+	d.SetId(compositeID.String())
+
+	if err := d.Set("slo_id", s.SloID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", s.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", s.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("budgeting_method", s.BudgetingMethod); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("group_by", s.GroupBy); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tags", s.Tags); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("summary", []interface{}{flattenSloSummary(*s)}); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("instances", instances); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenSloSummary(s models.Slo) map[string]interface{} {
+	return map[string]interface{}{
+		"status":                    string(s.Summary.Status),
+		"sli_value":                 s.Summary.SliValue,
+		"error_budget_initial":      s.Summary.ErrorBudget.Initial,
+		"error_budget_consumed":     s.Summary.ErrorBudget.Consumed,
+		"error_budget_remaining":    s.Summary.ErrorBudget.Remaining,
+		"error_budget_is_estimated": s.Summary.ErrorBudget.IsEstimated,
+	}
+}
+
+// kqlQuote quotes a value to be matched as a phrase in a KQL query.
+func kqlQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
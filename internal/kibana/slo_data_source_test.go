@@ -0,0 +1,91 @@
+package kibana_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceKibanaSlo(t *testing.T) {
+	sloName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSloDestroy,
+		ProtoV6ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(version.Must(version.NewSemver("8.12.0"))),
+				Config:   testAccDataSourceSlo(sloName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.elasticstack_kibana_slo.by_id", "name", "elasticstack_kibana_slo.test_slo", "name"),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_slo.by_id", "description", "data source SLO"),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_slo.by_id", "budgeting_method", "occurrences"),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_slo.by_id", "group_by.0", "labels.groupId"),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_slo.by_id", "tags.0", "tag-1"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_kibana_slo.by_id", "summary.0.status"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_kibana_slo.by_id", "summary.0.sli_value"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_kibana_slo.by_id", "summary.0.error_budget_remaining"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_kibana_slo.by_id", "summary.0.error_budget_consumed"),
+					resource.TestCheckResourceAttrPair("data.elasticstack_kibana_slo.by_name", "slo_id", "elasticstack_kibana_slo.test_slo", "slo_id"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_kibana_slo.by_name", "summary.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSlo(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_elasticsearch_index" "my_index" {
+  name                = "my-index"
+  deletion_protection = false
+}
+
+resource "elasticstack_kibana_slo" "test_slo" {
+  name        = "%s"
+  description = "data source SLO"
+
+  kql_custom_indicator {
+    index           = "my-index"
+    good            = "latency < 300"
+    total           = "*"
+    timestamp_field = "@timestamp"
+  }
+
+  time_window {
+    duration = "7d"
+    type     = "rolling"
+  }
+
+  budgeting_method = "occurrences"
+
+  objective {
+    target = 0.99
+  }
+
+  group_by = ["labels.groupId"]
+  tags     = ["tag-1"]
+
+  depends_on = [elasticstack_elasticsearch_index.my_index]
+}
+
+data "elasticstack_kibana_slo" "by_id" {
+  slo_id = elasticstack_kibana_slo.test_slo.slo_id
+}
+
+data "elasticstack_kibana_slo" "by_name" {
+  name = elasticstack_kibana_slo.test_slo.name
+}
+`, name)
+}
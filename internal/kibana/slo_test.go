@@ -28,6 +28,15 @@ func TestAccResourceSlo(t *testing.T) {
 	slo8_10Constraints, err := version.NewConstraint(">=8.10.0,!=8.11.0,!=8.11.1,!=8.11.2,!=8.11.3,!=8.11.4")
 	require.NoError(t, err)
 
+	slo8_12Constraints, err := version.NewConstraint(">=8.12.0")
+	require.NoError(t, err)
+
+	slo8_14Constraints, err := version.NewConstraint(">=8.14.0")
+	require.NoError(t, err)
+
+	slo8_15Constraints, err := version.NewConstraint(">=8.15.0")
+	require.NoError(t, err)
+
 	sloName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -36,7 +45,7 @@ func TestAccResourceSlo(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(slo8_9Constraints),
-				Config:   getSLOConfig(sloName, "apm_latency_indicator", false, []string{}, ""),
+				Config:   getSLOConfig(sloName, "apm_latency_indicator", false, []string{}, nil),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "name", sloName),
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "slo_id", "fully-sick-slo"),
@@ -60,14 +69,14 @@ func TestAccResourceSlo(t *testing.T) {
 			},
 			{ //check that name can be updated
 				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(slo8_9Constraints),
-				Config:   getSLOConfig(fmt.Sprintf("Updated %s", sloName), "apm_latency_indicator", false, []string{}, ""),
+				Config:   getSLOConfig(fmt.Sprintf("Updated %s", sloName), "apm_latency_indicator", false, []string{}, nil),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "name", fmt.Sprintf("Updated %s", sloName)),
 				),
 			},
 			{ //check that settings can be updated from api-computed defaults
 				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(slo8_9Constraints),
-				Config:   getSLOConfig(sloName, "apm_latency_indicator", true, []string{}, ""),
+				Config:   getSLOConfig(sloName, "apm_latency_indicator", true, []string{}, nil),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "settings.0.sync_delay", "5m"),
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "settings.0.frequency", "5m"),
@@ -75,7 +84,7 @@ func TestAccResourceSlo(t *testing.T) {
 			},
 			{
 				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(slo8_9Constraints),
-				Config:   getSLOConfig(sloName, "apm_availability_indicator", true, []string{}, ""),
+				Config:   getSLOConfig(sloName, "apm_availability_indicator", true, []string{}, nil),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "apm_availability_indicator.0.environment", "production"),
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "apm_availability_indicator.0.service", "my-service"),
@@ -86,7 +95,7 @@ func TestAccResourceSlo(t *testing.T) {
 			},
 			{
 				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(slo8_9Constraints),
-				Config:   getSLOConfig(sloName, "kql_custom_indicator", true, []string{}, ""),
+				Config:   getSLOConfig(sloName, "kql_custom_indicator", true, []string{}, nil),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "kql_custom_indicator.0.index", "my-index"),
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "kql_custom_indicator.0.good", "latency < 300"),
@@ -97,7 +106,7 @@ func TestAccResourceSlo(t *testing.T) {
 			},
 			{
 				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(slo8_10Constraints),
-				Config:   getSLOConfig(sloName, "histogram_custom_indicator", true, []string{}, ""),
+				Config:   getSLOConfig(sloName, "histogram_custom_indicator", true, []string{}, nil),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "histogram_custom_indicator.0.index", "my-index"),
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "histogram_custom_indicator.0.good.0.field", "test"),
@@ -111,7 +120,7 @@ func TestAccResourceSlo(t *testing.T) {
 			},
 			{
 				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(slo8_10Constraints),
-				Config:   getSLOConfig(sloName, "metric_custom_indicator", true, []string{}, "some.field"),
+				Config:   getSLOConfig(sloName, "metric_custom_indicator", true, []string{}, []string{"some.field"}),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "metric_custom_indicator.0.index", "my-index"),
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "metric_custom_indicator.0.good.0.metrics.0.name", "A"),
@@ -129,15 +138,61 @@ func TestAccResourceSlo(t *testing.T) {
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "metric_custom_indicator.0.total.0.metrics.1.aggregation", "sum"),
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "metric_custom_indicator.0.total.0.metrics.1.field", "processor.accepted"),
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "metric_custom_indicator.0.total.0.equation", "A + B"),
-					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "group_by", "some.field"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "group_by.#", "1"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "group_by.0", "some.field"),
 				),
 			},
 			{
 				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(slo8_10Constraints),
-				Config:   getSLOConfig(sloName, "metric_custom_indicator", true, []string{"tag-1", "another_tag"}, ""),
+				Config:   getSLOConfig(sloName, "metric_custom_indicator", true, []string{"tag-1", "another_tag"}, nil),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "tags.0", "tag-1"),
 					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "tags.1", "another_tag"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "group_by.#", "0"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(slo8_12Constraints),
+				Config:   getSLOConfig(sloName, "timeslice_metric_indicator", true, []string{}, nil),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.index", "my-index"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.timestamp_field", "@timestamp"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.metric.0.metrics.0.name", "A"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.metric.0.metrics.0.aggregation", "sum"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.metric.0.metrics.0.field", "latency"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.metric.0.metrics.1.name", "B"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.metric.0.metrics.1.aggregation", "percentile"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.metric.0.metrics.1.field", "latency"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.metric.0.metrics.1.percentile", "99"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.metric.0.metrics.2.name", "C"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.metric.0.metrics.2.aggregation", "doc_count"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.metric.0.metrics.2.filter", "status_code: 500"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.metric.0.equation", "(A + B) / C"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.metric.0.comparator", "GT"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "timeslice_metric_indicator.0.metric.0.threshold", "100"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(slo8_14Constraints),
+				Config:   getSLOConfig(sloName, "kql_custom_indicator", true, []string{}, []string{"service.name", "cloud.region"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "group_by.#", "2"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "group_by.0", "service.name"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "group_by.1", "cloud.region"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionMeetsConstraints(slo8_15Constraints),
+				Config: strings.Replace(
+					getSLOConfig(sloName, "kql_custom_indicator", true, []string{}, nil),
+					`frequency = "5m"`,
+					`frequency = "5m"
+			prevent_initial_backfill = true`,
+					1,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "settings.0.prevent_initial_backfill", "true"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_slo.test_slo", "group_by.#", "0"),
 				),
 			},
 		},
@@ -200,7 +255,7 @@ func TestAccResourceSloErrors(t *testing.T) {
 
 	}`
 
-	budgetingMethodFailConfig := getSLOConfig("budgetingMethodFail", "apm_latency_indicator", false, []string{}, "")
+	budgetingMethodFailConfig := getSLOConfig("budgetingMethodFail", "apm_latency_indicator", false, []string{}, nil)
 	budgetingMethodFailConfig = strings.Replace(budgetingMethodFailConfig, "budgeting_method = \"timeslices\"", "budgeting_method = \"supdawg\"", -1)
 
 	resource.Test(t, resource.TestCase{
@@ -214,7 +269,7 @@ func TestAccResourceSloErrors(t *testing.T) {
 			},
 			{
 				SkipFunc:    versionutils.CheckIfVersionIsUnsupported(version.Must(version.NewSemver("8.10.0-SNAPSHOT"))),
-				Config:      getSLOConfig("failwhale", "histogram_custom_indicator_agg_fail", false, []string{}, ""),
+				Config:      getSLOConfig("failwhale", "histogram_custom_indicator_agg_fail", false, []string{}, nil),
 				ExpectError: regexp.MustCompile(`expected histogram_custom_indicator.0.good.0.aggregation to be one of \["?value_count"? "?range"?\], got supdawg`),
 			},
 			{
@@ -252,7 +307,7 @@ func checkResourceSloDestroy(s *terraform.State) error {
 	return nil
 }
 
-func getSLOConfig(name string, indicatorType string, settingsEnabled bool, tags []string, group_by string) string {
+func getSLOConfig(name string, indicatorType string, settingsEnabled bool, tags []string, groupBy []string) string {
 	var settings string
 	if settingsEnabled {
 		settings = `
@@ -274,8 +329,9 @@ func getSLOConfig(name string, indicatorType string, settingsEnabled bool, tags
 	}
 
 	var groupByOption string
-	if len(group_by) != 0 {
-		groupByOption = "group_by = \"" + group_by + "\""
+	if len(groupBy) != 0 {
+		groupByJson, _ := json.Marshal(groupBy)
+		groupByOption = "group_by = " + string(groupByJson)
 	} else {
 		groupByOption = ""
 	}
@@ -430,6 +486,34 @@ func getSLOConfig(name string, indicatorType string, settingsEnabled bool, tags
 			}
 		}
 		  `
+
+		case "timeslice_metric_indicator":
+			indicator = `
+		timeslice_metric_indicator {
+			index = "my-index"
+			metric {
+				metrics {
+						name = "A"
+						aggregation = "sum"
+						field = "latency"
+				}
+				metrics {
+						name = "B"
+						aggregation = "percentile"
+						field = "latency"
+						percentile = 99
+				}
+				metrics {
+						name = "C"
+						aggregation = "doc_count"
+						filter = "status_code: 500"
+				}
+				equation = "(A + B) / C"
+				comparator = "GT"
+				threshold = 100
+			}
+		}
+		  `
 		}
 		return indicator
 	}
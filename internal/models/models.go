@@ -73,13 +73,16 @@ type UserPassword struct {
 }
 
 type Role struct {
-	Name         string                 `json:"-"`
-	Applications []Application          `json:"applications,omitempty"`
-	Global       map[string]interface{} `json:"global,omitempty"`
-	Cluster      []string               `json:"cluster,omitempty"`
-	Indices      []IndexPerms           `json:"indices,omitempty"`
-	Metadata     map[string]interface{} `json:"metadata,omitempty"`
-	RusAs        []string               `json:"run_as,omitempty"`
+	Name          string                 `json:"-"`
+	Description   *string                `json:"description,omitempty"`
+	Applications  []Application          `json:"applications,omitempty"`
+	Global        map[string]interface{} `json:"global,omitempty"`
+	Cluster       []string               `json:"cluster,omitempty"`
+	Indices       []IndexPerms           `json:"indices,omitempty"`
+	RemoteIndices []RemoteIndexPerms     `json:"remote_indices,omitempty"`
+	RemoteCluster []RemoteClusterPerms   `json:"remote_cluster,omitempty"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+	RusAs         []string               `json:"run_as,omitempty"`
 }
 
 type RoleMapping struct {
@@ -98,14 +101,92 @@ type ApiKey struct {
 	Metadata         map[string]interface{} `json:"metadata,omitempty"`
 }
 
+type ApiKeyUpdate struct {
+	RolesDescriptors *map[string]Role        `json:"role_descriptors,omitempty"`
+	Expiration       string                  `json:"expiration,omitempty"`
+	Metadata         *map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type CrossClusterApiKey struct {
+	Name       string                    `json:"name,omitempty"`
+	Access     *CrossClusterApiKeyAccess `json:"access,omitempty"`
+	Expiration string                    `json:"expiration,omitempty"`
+	Metadata   *map[string]interface{}   `json:"metadata,omitempty"`
+}
+
+type CrossClusterApiKeyAccess struct {
+	Search      []CrossClusterApiKeyAccessEntry `json:"search,omitempty"`
+	Replication []CrossClusterApiKeyAccessEntry `json:"replication,omitempty"`
+}
+
+type CrossClusterApiKeyAccessEntry struct {
+	Names                  []string       `json:"names"`
+	FieldSecurity          *FieldSecurity `json:"field_security,omitempty"`
+	Query                  *string        `json:"query,omitempty"`
+	AllowRestrictedIndices *bool          `json:"allow_restricted_indices,omitempty"`
+}
+
 type ApiKeyResponse struct {
 	ApiKey
-	RolesDescriptors map[string]Role `json:"role_descriptors,omitempty"`
-	Expiration       int64           `json:"expiration,omitempty"`
-	Id               string          `json:"id,omitempty"`
-	Key              string          `json:"api_key,omitempty"`
-	EncodedKey       string          `json:"encoded,omitempty"`
-	Invalidated      bool            `json:"invalidated,omitempty"`
+	RolesDescriptors map[string]Role           `json:"role_descriptors,omitempty"`
+	Expiration       int64                     `json:"expiration,omitempty"`
+	Creation         int64                     `json:"creation,omitempty"`
+	Id               string                    `json:"id,omitempty"`
+	Key              string                    `json:"api_key,omitempty"`
+	EncodedKey       string                    `json:"encoded,omitempty"`
+	Invalidated      bool                      `json:"invalidated,omitempty"`
+	Type             string                    `json:"type,omitempty"`
+	Access           *CrossClusterApiKeyAccess `json:"access,omitempty"`
+}
+
+type ApplicationPrivilege struct {
+	Application string                 `json:"application,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Actions     []string               `json:"actions"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type ServiceToken struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type ServiceCredentials struct {
+	ServiceAccount string                 `json:"service_account"`
+	Count          int                    `json:"count"`
+	Tokens         map[string]interface{} `json:"tokens"`
+}
+
+type HasPrivilegesRequest struct {
+	Cluster     []string      `json:"cluster,omitempty"`
+	Index       []IndexPerms  `json:"index,omitempty"`
+	Application []Application `json:"application,omitempty"`
+}
+
+type HasPrivilegesResponse struct {
+	Username        string                                `json:"username"`
+	HasAllRequested bool                                  `json:"has_all_requested"`
+	Cluster         map[string]bool                       `json:"cluster"`
+	Index           map[string]map[string]bool            `json:"index"`
+	Application     map[string]map[string]map[string]bool `json:"application"`
+}
+
+type UserPrivileges struct {
+	Cluster       []string                 `json:"cluster"`
+	Global        []map[string]interface{} `json:"global"`
+	Indices       []UserIndexPrivileges    `json:"indices"`
+	RemoteIndices []UserIndexPrivileges    `json:"remote_indices"`
+	Applications  []Application            `json:"applications"`
+	RunAs         []string                 `json:"run_as"`
+}
+
+type UserIndexPrivileges struct {
+	Names                  []string        `json:"names"`
+	Privileges             []string        `json:"privileges"`
+	FieldSecurity          []FieldSecurity `json:"field_security,omitempty"`
+	Query                  []string        `json:"query,omitempty"`
+	AllowRestrictedIndices bool            `json:"allow_restricted_indices"`
+	Clusters               []string        `json:"clusters,omitempty"`
 }
 
 type IndexPerms struct {
@@ -116,6 +197,16 @@ type IndexPerms struct {
 	AllowRestrictedIndices *bool          `json:"allow_restricted_indices,omitempty"`
 }
 
+type RemoteIndexPerms struct {
+	IndexPerms
+	Clusters []string `json:"clusters"`
+}
+
+type RemoteClusterPerms struct {
+	Clusters   []string `json:"clusters"`
+	Privileges []string `json:"privileges"`
+}
+
 type FieldSecurity struct {
 	Grant  []string `json:"grant,omitempty"`
 	Except []string `json:"except,omitempty"`
@@ -151,6 +242,16 @@ type Template struct {
 	Settings map[string]interface{} `json:"settings,omitempty"`
 }
 
+type SimulatedIndexTemplate struct {
+	Template    Template                   `json:"template"`
+	Overlapping []OverlappingIndexTemplate `json:"overlapping"`
+}
+
+type OverlappingIndexTemplate struct {
+	Name          string   `json:"name"`
+	IndexPatterns []string `json:"index_patterns"`
+}
+
 type IndexTemplatesResponse struct {
 	IndexTemplates []IndexTemplateResponse `json:"index_templates"`
 }
@@ -178,6 +279,7 @@ type ComponentTemplateResponse struct {
 
 type PolicyDefinition struct {
 	Policy   Policy `json:"policy"`
+	Version  int    `json:"version"`
 	Modified string `json:"modified_date"`
 }
 
@@ -194,6 +296,38 @@ type Phase struct {
 
 type Action map[string]interface{}
 
+type IlmExplainResponse struct {
+	Indices map[string]IlmExplainIndex `json:"indices"`
+}
+
+type IlmExplainIndex struct {
+	Index                string                 `json:"index"`
+	Managed              bool                   `json:"managed"`
+	Policy               string                 `json:"policy,omitempty"`
+	Age                  string                 `json:"age,omitempty"`
+	LifecycleDateMillis  int64                  `json:"lifecycle_date_millis,omitempty"`
+	Phase                string                 `json:"phase,omitempty"`
+	Action               string                 `json:"action,omitempty"`
+	Step                 string                 `json:"step,omitempty"`
+	FailedStep           string                 `json:"failed_step,omitempty"`
+	FailedStepRetryCount int                    `json:"failed_step_retry_count,omitempty"`
+	IsAutoRetryableError bool                   `json:"is_auto_retryable_error,omitempty"`
+	StepInfo             map[string]interface{} `json:"step_info,omitempty"`
+	PhaseExecution       *IlmPhaseExecution     `json:"phase_execution,omitempty"`
+}
+
+type IlmPhaseExecution struct {
+	Policy          string                 `json:"policy"`
+	PhaseDefinition map[string]interface{} `json:"phase_definition,omitempty"`
+	Version         int                    `json:"version"`
+}
+
+type IlmStepKey struct {
+	Phase  string `json:"phase"`
+	Action string `json:"action,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
 type SnapshotRepository struct {
 	Name     string                 `json:"-"`
 	Type     string                 `json:"type"`
@@ -210,6 +344,27 @@ type SnapshotPolicy struct {
 	Schedule   string                `json:"schedule"`
 }
 
+type SnapshotPolicyInfo struct {
+	Policy        SnapshotPolicy            `json:"policy"`
+	LastSuccess   *SnapshotPolicyInvocation `json:"last_success,omitempty"`
+	LastFailure   *SnapshotPolicyInvocation `json:"last_failure,omitempty"`
+	NextExecution string                    `json:"next_execution,omitempty"`
+	Stats         *SnapshotPolicyStats      `json:"stats,omitempty"`
+}
+
+type SnapshotPolicyInvocation struct {
+	SnapshotName string `json:"snapshot_name"`
+	Time         string `json:"time_string"`
+	Details      string `json:"details,omitempty"`
+}
+
+type SnapshotPolicyStats struct {
+	SnapshotsTaken           int `json:"snapshots_taken"`
+	SnapshotsFailed          int `json:"snapshots_failed"`
+	SnapshotsDeleted         int `json:"snapshots_deleted"`
+	SnapshotDeletionFailures int `json:"snapshot_deletion_failures"`
+}
+
 type SnapshortRetention struct {
 	ExpireAfter *string `json:"expire_after,omitempty"`
 	MaxCount    *int    `json:"max_count,omitempty"`
@@ -226,6 +381,116 @@ type SnapshotPolicyConfig struct {
 	Partial            *bool                  `json:"partial,omitempty"`
 }
 
+type Snapshot struct {
+	Snapshot           string                 `json:"snapshot"`
+	UUID               string                 `json:"uuid"`
+	Version            string                 `json:"version"`
+	Indices            []string               `json:"indices"`
+	DataStreams        []string               `json:"data_streams"`
+	FeatureStates      []SnapshotFeatureState `json:"feature_states"`
+	IncludeGlobalState bool                   `json:"include_global_state"`
+	Metadata           map[string]interface{} `json:"metadata,omitempty"`
+	State              string                 `json:"state"`
+	Reason             string                 `json:"reason,omitempty"`
+	StartTime          string                 `json:"start_time"`
+	EndTime            string                 `json:"end_time"`
+	Failures           []SnapshotShardFailure `json:"failures"`
+	Shards             SnapshotShards         `json:"shards"`
+}
+
+type SnapshotFeatureState struct {
+	FeatureName string   `json:"feature_name"`
+	Indices     []string `json:"indices"`
+}
+
+type SnapshotShardFailure struct {
+	Index   string `json:"index"`
+	ShardId int    `json:"shard_id"`
+	Reason  string `json:"reason"`
+	Status  string `json:"status"`
+}
+
+type SnapshotShards struct {
+	Total      int `json:"total"`
+	Failed     int `json:"failed"`
+	Successful int `json:"successful"`
+}
+
+type SnapshotRestore struct {
+	Indices             []string               `json:"indices,omitempty"`
+	IgnoreUnavailable   *bool                  `json:"ignore_unavailable,omitempty"`
+	IncludeGlobalState  *bool                  `json:"include_global_state,omitempty"`
+	IncludeAliases      *bool                  `json:"include_aliases,omitempty"`
+	FeatureStates       []string               `json:"feature_states,omitempty"`
+	Partial             *bool                  `json:"partial,omitempty"`
+	RenamePattern       string                 `json:"rename_pattern,omitempty"`
+	RenameReplacement   string                 `json:"rename_replacement,omitempty"`
+	IndexSettings       map[string]interface{} `json:"index_settings,omitempty"`
+	IgnoreIndexSettings []string               `json:"ignore_index_settings,omitempty"`
+}
+
+type SearchableSnapshotMount struct {
+	Index               string                 `json:"index"`
+	RenamedIndex        string                 `json:"renamed_index,omitempty"`
+	IndexSettings       map[string]interface{} `json:"index_settings,omitempty"`
+	IgnoreIndexSettings []string               `json:"ignore_index_settings,omitempty"`
+}
+
+type SnapshotRestoreInfo struct {
+	Snapshot string         `json:"snapshot"`
+	Indices  []string       `json:"indices"`
+	Shards   SnapshotShards `json:"shards"`
+}
+
+type SnapshotRepositoryVerifyNode struct {
+	Name string `json:"name"`
+}
+
+type SnapshotRepositoryAnalysis struct {
+	CoordinatingNode   SnapshotRepositoryAnalysisNode    `json:"coordinating_node"`
+	BlobPath           string                            `json:"blob_path"`
+	BlobCount          int                               `json:"blob_count"`
+	Concurrency        int                               `json:"concurrency"`
+	ReadNodeCount      int                               `json:"read_node_count"`
+	EarlyReadNodeCount int                               `json:"early_read_node_count"`
+	MaxBlobSize        string                            `json:"max_blob_size"`
+	MaxTotalDataSize   string                            `json:"max_total_data_size"`
+	Seed               int64                             `json:"seed"`
+	ListingElapsed     int64                             `json:"listing_elapsed_nanos"`
+	DeleteElapsed      int64                             `json:"delete_elapsed_nanos"`
+	Summary            SnapshotRepositoryAnalysisSummary `json:"summary"`
+}
+
+type AnalyzeSnapshotRepositoryParams struct {
+	BlobCount          int
+	Concurrency        int
+	ReadNodeCount      int
+	EarlyReadNodeCount int
+	MaxBlobSize        string
+	MaxTotalDataSize   string
+	Seed               *int
+	Timeout            time.Duration
+}
+
+type SnapshotRepositoryAnalysisNode struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type SnapshotRepositoryAnalysisSummary struct {
+	Write SnapshotRepositoryAnalysisStats `json:"write"`
+	Read  SnapshotRepositoryAnalysisStats `json:"read"`
+}
+
+type SnapshotRepositoryAnalysisStats struct {
+	Count          int64 `json:"count"`
+	TotalSizeBytes int64 `json:"total_size_bytes"`
+	TotalThrottled int64 `json:"total_throttled_nanos"`
+	TotalWait      int64 `json:"total_wait_nanos"`
+	MaxWait        int64 `json:"max_wait_nanos"`
+	TotalElapsed   int64 `json:"total_elapsed_nanos"`
+}
+
 type StringSliceOrCSV []string
 
 var ErrInvalidStringSliceOrCSV = errors.New("expected array of strings, or a csv string")
@@ -276,6 +541,37 @@ type IndexAlias struct {
 	SearchRouting string                 `json:"search_routing,omitempty"`
 }
 
+type IndexAliasAction struct {
+	Add         *IndexAliasActionParams `json:"add,omitempty"`
+	Remove      *IndexAliasActionParams `json:"remove,omitempty"`
+	RemoveIndex *IndexAliasActionParams `json:"remove_index,omitempty"`
+}
+
+type IndexAliasActionParams struct {
+	Index string `json:"index"`
+	Alias string `json:"alias,omitempty"`
+	*IndexAlias
+}
+
+type ReindexStatus struct {
+	Total    int64                    `json:"total"`
+	Created  int64                    `json:"created"`
+	Updated  int64                    `json:"updated"`
+	Deleted  int64                    `json:"deleted"`
+	Failures []map[string]interface{} `json:"failures,omitempty"`
+}
+
+type ReindexTaskInfo struct {
+	Status ReindexStatus `json:"status"`
+}
+
+type ReindexTask struct {
+	Completed bool                   `json:"completed"`
+	Task      ReindexTaskInfo        `json:"task"`
+	Response  *ReindexStatus         `json:"response,omitempty"`
+	Error     map[string]interface{} `json:"error,omitempty"`
+}
+
 type DataStream struct {
 	Name           string                 `json:"name"`
 	TimestampField TimestampField         `json:"timestamp_field"`
@@ -14,6 +14,8 @@ type Slo struct {
 	Objective       slo.Objective
 	Settings        *slo.Settings
 	SpaceID         string
-	GroupBy         *string
+	GroupBy         []string
 	Tags            []string
+	InstanceID      string
+	Summary         slo.Summary
 }
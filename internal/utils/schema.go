@@ -13,6 +13,47 @@ func ExpandStringSet(set *schema.Set) []string {
 	return strs
 }
 
+func ExpandStringList(list []interface{}) []string {
+	strs := make([]string, len(list))
+	for i, v := range list {
+		strs[i] = v.(string)
+	}
+	return strs
+}
+
 func IsKnown(val attr.Value) bool {
 	return !(val.IsNull() || val.IsUnknown())
 }
+
+// ListElemSchema converts the schema of a data source returning a single object into the element schema of a data
+// source listing them.
+func ListElemSchema(s map[string]*schema.Schema) *schema.Resource {
+	delete(s, "id")
+	delete(s, "elasticsearch_connection")
+	for _, attr := range s {
+		ToComputedSchema(attr)
+	}
+	return &schema.Resource{Schema: s}
+}
+
+// ToComputedSchema turns the schema of an argument into the schema of an attribute, with the same nested attributes.
+func ToComputedSchema(s *schema.Schema) {
+	s.Required = false
+	s.Optional = false
+	s.Computed = true
+	s.ForceNew = false
+	s.Default = nil
+	s.ValidateFunc = nil
+	s.DiffSuppressFunc = nil
+	s.MaxItems = 0
+	s.MinItems = 0
+	s.AtLeastOneOf = nil
+	s.ConflictsWith = nil
+	s.ExactlyOneOf = nil
+	s.RequiredWith = nil
+	if elem, ok := s.Elem.(*schema.Resource); ok {
+		for _, attr := range elem.Schema {
+			ToComputedSchema(attr)
+		}
+	}
+}
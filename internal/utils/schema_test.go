@@ -38,3 +38,35 @@ func TestExpandStringSet(t *testing.T) {
 		})
 	}
 }
+
+func TestListElemSchema(t *testing.T) {
+	t.Parallel()
+
+	elem := ListElemSchema(map[string]*schema.Schema{
+		"id":                       {Type: schema.TypeString, Computed: true},
+		"elasticsearch_connection": {Type: schema.TypeList, Optional: true},
+		"name":                     {Type: schema.TypeString, Required: true, ForceNew: true},
+		"settings": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"priority": {Type: schema.TypeInt, Optional: true, Default: 1},
+				},
+			},
+		},
+	})
+
+	if _, ok := elem.Schema["id"]; ok {
+		t.Errorf("ListElemSchema() kept the id attribute")
+	}
+	if _, ok := elem.Schema["elasticsearch_connection"]; ok {
+		t.Errorf("ListElemSchema() kept the elasticsearch_connection block")
+	}
+	for _, s := range []*schema.Schema{elem.Schema["name"], elem.Schema["settings"], elem.Schema["settings"].Elem.(*schema.Resource).Schema["priority"]} {
+		if s.Required || s.Optional || !s.Computed || s.ForceNew || s.MaxItems != 0 || s.Default != nil {
+			t.Errorf("ListElemSchema() = %+v, want a computed only attribute", s)
+		}
+	}
+}
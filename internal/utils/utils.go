@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -212,3 +213,13 @@ func FlipMap[K comparable, V comparable](m map[K]V) map[V]K {
 	}
 	return inv
 }
+
+// SortedKeys returns the keys of the map in ascending order.
+func SortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
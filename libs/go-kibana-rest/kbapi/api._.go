@@ -14,6 +14,7 @@ type API struct {
 	KibanaLogstashPipeline *KibanaLogstashPipelineAPI
 	KibanaShortenURL       *KibanaShortenURLAPI
 	KibanaSynthetics       *KibanaSyntheticsAPI
+	KibanaFeatures         *KibanaFeaturesAPI
 }
 
 // KibanaSpacesAPI handle the spaces API
@@ -69,6 +70,11 @@ type KibanaShortenURLAPI struct {
 	Create KibanaShortenURLCreate
 }
 
+// KibanaFeaturesAPI handle the features API
+type KibanaFeaturesAPI struct {
+	List KibanaFeatureList
+}
+
 type KibanaSyntheticsAPI struct {
 	Monitor         *KibanaSyntheticsMonitorAPI
 	PrivateLocation *KibanaSyntheticsPrivateLocationAPI
@@ -129,5 +135,8 @@ func New(c *resty.Client) *API {
 				Get:    newKibanaSyntheticsPrivateLocationGetFunc(c),
 			},
 		},
+		KibanaFeatures: &KibanaFeaturesAPI{
+			List: newKibanaFeatureListFunc(c),
+		},
 	}
 }
@@ -0,0 +1,85 @@
+package kbapi
+
+import (
+	"encoding/json"
+
+	"github.com/go-resty/resty/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	basePathKibanaFeatures = "/api/features" // Base URL to access on Kibana features API
+)
+
+// KibanaFeature is the Feature API object
+type KibanaFeature struct {
+	ID                        string                            `json:"id"`
+	Name                      string                            `json:"name"`
+	Description               string                            `json:"description,omitempty"`
+	Category                  *KibanaFeatureCategory            `json:"category,omitempty"`
+	Privileges                map[string]map[string]interface{} `json:"privileges,omitempty"`
+	SubFeatures               []KibanaFeatureSubFeature         `json:"subFeatures,omitempty"`
+	ExcludeFromBasePrivileges bool                              `json:"excludeFromBasePrivileges,omitempty"`
+	MinimumLicense            string                            `json:"minimumLicense,omitempty"`
+}
+
+// KibanaFeatureCategory is the Feature category object
+type KibanaFeatureCategory struct {
+	ID    string `json:"id"`
+	Label string `json:"label,omitempty"`
+}
+
+// KibanaFeatureSubFeature is the Feature sub-feature object
+type KibanaFeatureSubFeature struct {
+	Name            string                                  `json:"name"`
+	PrivilegeGroups []KibanaFeatureSubFeaturePrivilegeGroup `json:"privilegeGroups,omitempty"`
+}
+
+// KibanaFeatureSubFeaturePrivilegeGroup is the Feature sub-feature privilege group object
+type KibanaFeatureSubFeaturePrivilegeGroup struct {
+	GroupType  string                             `json:"groupType"`
+	Privileges []KibanaFeatureSubFeaturePrivilege `json:"privileges,omitempty"`
+}
+
+// KibanaFeatureSubFeaturePrivilege is the Feature sub-feature privilege object
+type KibanaFeatureSubFeaturePrivilege struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	IncludeIn string `json:"includeIn,omitempty"`
+}
+
+// KibanaFeatures is the list of KibanaFeature object
+type KibanaFeatures []KibanaFeature
+
+// KibanaFeatureList permit to get all features
+type KibanaFeatureList func() (KibanaFeatures, error)
+
+// String permit to return KibanaFeature object as JSON string
+func (k *KibanaFeature) String() string {
+	json, _ := json.Marshal(k)
+	return string(json)
+}
+
+// newKibanaFeatureListFunc permit to get all Kibana features
+func newKibanaFeatureListFunc(c *resty.Client) KibanaFeatureList {
+	return func() (KibanaFeatures, error) {
+
+		resp, err := c.R().Get(basePathKibanaFeatures)
+		if err != nil {
+			return nil, err
+		}
+		log.Debug("Response: ", resp)
+		if resp.StatusCode() >= 300 {
+			return nil, NewAPIError(resp.StatusCode(), resp.Status())
+		}
+		kibanaFeatures := make(KibanaFeatures, 0, 1)
+		err = json.Unmarshal(resp.Body(), &kibanaFeatures)
+		if err != nil {
+			return nil, err
+		}
+		log.Debug("KibanaFeatures: ", kibanaFeatures)
+
+		return kibanaFeatures, nil
+	}
+
+}
@@ -0,0 +1,13 @@
+package kbapi
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *KBAPITestSuite) TestKibanaFeatures() {
+
+	// List kibana features
+	kibanaFeatures, err := s.API.KibanaFeatures.List()
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), kibanaFeatures)
+}
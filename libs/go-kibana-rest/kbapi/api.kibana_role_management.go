@@ -28,9 +28,10 @@ type KibanaRoleTransientMetadata struct {
 
 // KibanaRoleElasticsearch is the API Elasticsearch object
 type KibanaRoleElasticsearch struct {
-	Indices []KibanaRoleElasticsearchIndice `json:"indices,omitempty"`
-	Cluster []string                        `json:"cluster,omitempty"`
-	RunAs   []string                        `json:"run_as,omitempty"`
+	Indices       []KibanaRoleElasticsearchIndice       `json:"indices,omitempty"`
+	RemoteIndices []KibanaRoleElasticsearchRemoteIndice `json:"remote_indices,omitempty"`
+	Cluster       []string                              `json:"cluster,omitempty"`
+	RunAs         []string                              `json:"run_as,omitempty"`
 }
 
 // KibanaRoleKibana is the API Kibana object
@@ -48,6 +49,15 @@ type KibanaRoleElasticsearchIndice struct {
 	Query         interface{}            `json:"query,omitempty"`
 }
 
+// KibanaRoleElasticsearchRemoteIndice is the API remote indice object
+type KibanaRoleElasticsearchRemoteIndice struct {
+	Clusters      []string               `json:"clusters,omitempty"`
+	Names         []string               `json:"names,omitempty"`
+	Privileges    []string               `json:"privileges,omitempty"`
+	FieldSecurity map[string]interface{} `json:"field_security,omitempty"`
+	Query         interface{}            `json:"query,omitempty"`
+}
+
 // KibanaRoles is a list of role object
 type KibanaRoles []KibanaRole
 
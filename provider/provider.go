@@ -34,6 +34,18 @@ func New(version string) *schema.Provider {
 			fleetKeyName: providerSchema.GetFleetConnectionSchema(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
+			"elasticstack_elasticsearch_component_template":                 index.DataSourceComponentTemplate(),
+			"elasticstack_elasticsearch_component_templates":                index.DataSourceComponentTemplates(),
+			"elasticstack_elasticsearch_data_stream":                        index.DataSourceDataStream(),
+			"elasticstack_elasticsearch_data_streams":                       index.DataSourceDataStreams(),
+			"elasticstack_elasticsearch_index":                              index.DataSourceIndex(),
+			"elasticstack_elasticsearch_indices":                            index.DataSourceIndices(),
+			"elasticstack_elasticsearch_index_lifecycle":                    index.DataSourceIlm(),
+			"elasticstack_elasticsearch_index_lifecycles":                   index.DataSourceIlms(),
+			"elasticstack_elasticsearch_index_lifecycle_explain":            index.DataSourceIlmExplain(),
+			"elasticstack_elasticsearch_index_template":                     index.DataSourceTemplate(),
+			"elasticstack_elasticsearch_index_templates":                    index.DataSourceTemplates(),
+			"elasticstack_elasticsearch_index_template_simulate":            index.DataSourceTemplateSimulate(),
 			"elasticstack_elasticsearch_ingest_processor_append":            ingest.DataSourceProcessorAppend(),
 			"elasticstack_elasticsearch_ingest_processor_bytes":             ingest.DataSourceProcessorBytes(),
 			"elasticstack_elasticsearch_ingest_processor_circle":            ingest.DataSourceProcessorCircle(),
@@ -72,39 +84,57 @@ func New(version string) *schema.Provider {
 			"elasticstack_elasticsearch_ingest_processor_urldecode":         ingest.DataSourceProcessorUrldecode(),
 			"elasticstack_elasticsearch_ingest_processor_uri_parts":         ingest.DataSourceProcessorUriParts(),
 			"elasticstack_elasticsearch_ingest_processor_user_agent":        ingest.DataSourceProcessorUserAgent(),
+			"elasticstack_elasticsearch_security_has_privileges":            security.DataSourceHasPrivileges(),
+			"elasticstack_elasticsearch_security_privilege":                 security.DataSourcePrivilege(),
 			"elasticstack_elasticsearch_security_role":                      security.DataSourceRole(),
 			"elasticstack_elasticsearch_security_role_mapping":              security.DataSourceRoleMapping(),
+			"elasticstack_elasticsearch_security_role_mapping_test":         security.DataSourceRoleMappingTest(),
+			"elasticstack_elasticsearch_security_roles":                     security.DataSourceRoles(),
 			"elasticstack_elasticsearch_security_user":                      security.DataSourceUser(),
+			"elasticstack_elasticsearch_security_user_privileges":           security.DataSourceUserPrivileges(),
+			"elasticstack_elasticsearch_security_users":                     security.DataSourceUsers(),
 			"elasticstack_elasticsearch_snapshot_repository":                cluster.DataSourceSnapshotRespository(),
+			"elasticstack_elasticsearch_snapshot_repository_verify":         cluster.DataSourceSnapshotRepositoryVerify(),
 			"elasticstack_elasticsearch_info":                               cluster.DataSourceClusterInfo(),
 			"elasticstack_elasticsearch_enrich_policy":                      enrich.DataSourceEnrichPolicy(),
 
 			"elasticstack_kibana_action_connector": kibana.DataSourceConnector(),
+			"elasticstack_kibana_features":         kibana.DataSourceFeatures(),
 			"elasticstack_kibana_security_role":    kibana.DataSourceRole(),
+			"elasticstack_kibana_slo":              kibana.DataSourceSlo(),
 
 			"elasticstack_fleet_enrollment_tokens": fleet.DataSourceEnrollmentTokens(),
 			"elasticstack_fleet_integration":       fleet.DataSourceIntegration(),
+			"elasticstack_fleet_uninstall_token":   fleet.DataSourceUninstallToken(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"elasticstack_elasticsearch_cluster_settings":      cluster.ResourceSettings(),
-			"elasticstack_elasticsearch_component_template":    index.ResourceComponentTemplate(),
-			"elasticstack_elasticsearch_data_stream":           index.ResourceDataStream(),
-			"elasticstack_elasticsearch_index":                 index.ResourceIndex(),
-			"elasticstack_elasticsearch_index_lifecycle":       index.ResourceIlm(),
-			"elasticstack_elasticsearch_index_template":        index.ResourceTemplate(),
-			"elasticstack_elasticsearch_ingest_pipeline":       ingest.ResourceIngestPipeline(),
-			"elasticstack_elasticsearch_logstash_pipeline":     logstash.ResourceLogstashPipeline(),
-			"elasticstack_elasticsearch_security_api_key":      security.ResourceApiKey(),
-			"elasticstack_elasticsearch_security_role":         security.ResourceRole(),
-			"elasticstack_elasticsearch_security_role_mapping": security.ResourceRoleMapping(),
-			"elasticstack_elasticsearch_security_user":         security.ResourceUser(),
-			"elasticstack_elasticsearch_security_system_user":  security.ResourceSystemUser(),
-			"elasticstack_elasticsearch_snapshot_lifecycle":    cluster.ResourceSlm(),
-			"elasticstack_elasticsearch_snapshot_repository":   cluster.ResourceSnapshotRepository(),
-			"elasticstack_elasticsearch_script":                cluster.ResourceScript(),
-			"elasticstack_elasticsearch_enrich_policy":         enrich.ResourceEnrichPolicy(),
-			"elasticstack_elasticsearch_transform":             transform.ResourceTransform(),
-			"elasticstack_elasticsearch_watch":                 watcher.ResourceWatch(),
+			"elasticstack_elasticsearch_cluster_settings":          cluster.ResourceSettings(),
+			"elasticstack_elasticsearch_component_template":        index.ResourceComponentTemplate(),
+			"elasticstack_elasticsearch_data_stream":               index.ResourceDataStream(),
+			"elasticstack_elasticsearch_index":                     index.ResourceIndex(),
+			"elasticstack_elasticsearch_index_alias":               index.ResourceIndexAlias(),
+			"elasticstack_elasticsearch_index_lifecycle":           index.ResourceIlm(),
+			"elasticstack_elasticsearch_index_lifecycle_step":      index.ResourceIlmStep(),
+			"elasticstack_elasticsearch_index_template":            index.ResourceTemplate(),
+			"elasticstack_elasticsearch_ingest_pipeline":           ingest.ResourceIngestPipeline(),
+			"elasticstack_elasticsearch_logstash_pipeline":         logstash.ResourceLogstashPipeline(),
+			"elasticstack_elasticsearch_searchable_snapshot_mount": cluster.ResourceSearchableSnapshotMount(),
+			"elasticstack_elasticsearch_security_api_key":          security.ResourceApiKey(),
+			"elasticstack_elasticsearch_security_privilege":        security.ResourcePrivilege(),
+			"elasticstack_elasticsearch_security_role":             security.ResourceRole(),
+			"elasticstack_elasticsearch_security_role_mapping":     security.ResourceRoleMapping(),
+			"elasticstack_elasticsearch_security_user":             security.ResourceUser(),
+			"elasticstack_elasticsearch_security_system_user":      security.ResourceSystemUser(),
+			"elasticstack_elasticsearch_security_service_token":    security.ResourceServiceToken(),
+			"elasticstack_elasticsearch_snapshot":                  cluster.ResourceSnapshot(),
+			"elasticstack_elasticsearch_snapshot_lifecycle":        cluster.ResourceSlm(),
+			"elasticstack_elasticsearch_slm_status":                cluster.ResourceSlmStatus(),
+			"elasticstack_elasticsearch_snapshot_repository":       cluster.ResourceSnapshotRepository(),
+			"elasticstack_elasticsearch_snapshot_restore":          cluster.ResourceSnapshotRestore(),
+			"elasticstack_elasticsearch_script":                    cluster.ResourceScript(),
+			"elasticstack_elasticsearch_enrich_policy":             enrich.ResourceEnrichPolicy(),
+			"elasticstack_elasticsearch_transform":                 transform.ResourceTransform(),
+			"elasticstack_elasticsearch_watch":                     watcher.ResourceWatch(),
 
 			"elasticstack_kibana_alerting_rule":    kibana.ResourceAlertingRule(),
 			"elasticstack_kibana_space":            kibana.ResourceSpace(),
@@ -113,6 +143,8 @@ func New(version string) *schema.Provider {
 			"elasticstack_kibana_slo":              kibana.ResourceSlo(),
 
 			"elasticstack_fleet_agent_policy":       fleet.ResourceAgentPolicy(),
+			"elasticstack_fleet_custom_integration": fleet.ResourceCustomIntegration(),
+			"elasticstack_fleet_enrollment_token":   fleet.ResourceEnrollmentToken(),
 			"elasticstack_fleet_output":             fleet.ResourceOutput(),
 			"elasticstack_fleet_server_host":        fleet.ResourceFleetServerHost(),
 			"elasticstack_fleet_integration":        fleet.ResourceIntegration(),